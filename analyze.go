@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	"github.com/j985chen/cli-ordle/solver"
+)
+
+// analyzeGame compares each guess of a finished game with the solver's best
+// guess at that point. id is the game's number in the history, or 0 for the
+// last game played
+func analyzeGame(id uint64) error {
+	g, err := loadLastGame()
+	if id != 0 {
+		g, err = loadHistoryGame(id)
+	}
+	if err != nil {
+		return err
+	}
+	if g == nil && id != 0 {
+		return fmt.Errorf("no game %d in the history, see the history subcommand", id)
+	} else if g == nil {
+		return fmt.Errorf("no finished game to analyze yet")
+	}
+	s, err := solver.New(g.Length())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("---       ANALYSIS       ---")
+	fmt.Printf("Answer: %s\n", g.Answer)
+	fmt.Println("Bits are how many times a guess halved the possible answers, expected is the")
+	fmt.Println("average over every answer that was still possible")
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tGuess\tPattern\tBefore\tAfter\tBits\tExpected\tBest guess\tExpected")
+	for i, guess := range g.Guesses {
+		before := len(s.Candidates)
+		expected := solver.Entropy(guess.Word, s.Candidates)
+		best := s.Best(1)[0]
+		if err = s.Apply(guess.Word, guess.Statuses); err != nil {
+			return fmt.Errorf("could not analyze guess %s: %v", guess.Word, err)
+		}
+		after := len(s.Candidates)
+		bits := math.Log2(float64(before) / float64(after))
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%d\t%.2f\t%.2f\t%s\t%.2f\n", i+1, guess.Word, solver.FormatPattern(guess.Statuses),
+			before, after, bits, expected, best.Word, best.Entropy)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if !g.Solved && len(s.Candidates) > 1 {
+		fmt.Println()
+		fmt.Printf("%d possible answers were left\n", len(s.Candidates))
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j985chen/cli-ordle/game"
+	"github.com/j985chen/cli-ordle/solver"
+	"github.com/j985chen/cli-ordle/words"
+)
+
+// width of the longest bar in the histogram
+const histogramWidth = 40
+
+// the most lost answers to list
+const maxListedLosses = 20
+
+// benchmark plays a solver strategy against every possible answer, in
+// parallel and without using any player data, and reports how well it did
+func benchmark(strategyName string, length int, maxGuesses int) error {
+	strategy, ok := solver.FindStrategy(strategyName)
+	if !ok {
+		return fmt.Errorf("--strategy should be one of %s", strategyNames())
+	}
+	if err := game.CheckGuessLimit(maxGuesses); err != nil {
+		return err
+	}
+	answers, err := words.Answers(length)
+	if err != nil {
+		return err
+	}
+
+	workers := runtime.NumCPU()
+	fmt.Printf("Playing %d games with the %s strategy, %d at a time...\n", len(answers), strategy.Name, workers)
+	start := time.Now()
+	guesses := newGuessCache(strategy)
+	if !strategy.Random {
+		// every game starts with the same guess, so choose it once up front
+		// rather than in every worker at the same time
+		s, err := solver.New(length)
+		if err != nil {
+			return err
+		}
+		guesses.next(s, "", nil)
+	}
+	results := make([]*game.Game, len(answers))
+	indexes := make(chan int)
+	errs := make(chan error, workers)
+	// closed when a worker fails, to stop handing out games
+	failed := make(chan struct{})
+	var failOnce sync.Once
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(seed))
+			for i := range indexes {
+				g, err := benchGame(answers[i], maxGuesses, guesses, r)
+				if err != nil {
+					errs <- err
+					failOnce.Do(func() { close(failed) })
+					return
+				}
+				results[i] = g
+			}
+		}(time.Now().UnixNano() + int64(w))
+	}
+send:
+	for i := range answers {
+		select {
+		case indexes <- i:
+		case <-failed:
+			break send
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	close(errs)
+	if err = <-errs; err != nil {
+		return err
+	}
+	printBenchmark(results, maxGuesses, time.Since(start))
+	return nil
+}
+
+// benchGame plays one game against the answer with the solver's guesses
+func benchGame(answer string, maxGuesses int, guesses *guessCache, r *rand.Rand) (*game.Game, error) {
+	s, err := solver.New(len(answer))
+	if err != nil {
+		return nil, err
+	}
+	g := game.NewGame(answer, game.Options{MaxGuesses: maxGuesses})
+	var history strings.Builder
+	for g.State() == game.Playing {
+		guess := guesses.next(s, history.String(), r)
+		result, err := g.Guess(guess)
+		if err != nil {
+			return nil, fmt.Errorf("strategy guessed %s for %s: %v", guess, answer, err)
+		}
+		if err = s.Apply(guess, result.Guess.Statuses); err != nil {
+			return nil, fmt.Errorf("could not apply %s for %s: %v", guess, answer, err)
+		}
+		fmt.Fprintf(&history, "%s:%s ", guess, solver.FormatPattern(result.Guess.Statuses))
+	}
+	return g, nil
+}
+
+// guessCache remembers the guess a strategy made after each history of guesses
+// and patterns, since strategies that aren't random always make the same guess
+// and most games share their first few
+type guessCache struct {
+	strategy solver.Strategy
+	mu       sync.Mutex
+	guesses  map[string]string
+}
+
+func newGuessCache(strategy solver.Strategy) *guessCache {
+	return &guessCache{strategy: strategy, guesses: map[string]string{}}
+}
+
+func (c *guessCache) next(s *solver.Solver, history string, r *rand.Rand) string {
+	if c.strategy.Random {
+		return c.strategy.Choose(s, r)
+	}
+	c.mu.Lock()
+	guess, ok := c.guesses[history]
+	c.mu.Unlock()
+	if !ok {
+		// games that reach the same history at once may both choose, which is harmless
+		guess = c.strategy.Choose(s, r)
+		c.mu.Lock()
+		c.guesses[history] = guess
+		c.mu.Unlock()
+	}
+	return guess
+}
+
+func printBenchmark(results []*game.Game, maxGuesses int, elapsed time.Duration) {
+	distribution := make([]int, maxGuesses)
+	won, totalGuesses, worst := 0, 0, 0
+	var lost []string
+	for _, g := range results {
+		if g.State() == game.Won {
+			won++
+			totalGuesses += len(g.Guesses)
+			distribution[len(g.Guesses)-1]++
+			if len(g.Guesses) > worst {
+				worst = len(g.Guesses)
+			}
+		} else {
+			lost = append(lost, g.Answer)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("---      BENCHMARK      ---")
+	fmt.Printf("Played: %d | Win%%: %.1f%% | Time: %s\n", len(results), float64(won)/float64(len(results))*100, elapsed.Round(time.Millisecond))
+	worstCase := strconv.Itoa(worst)
+	if len(lost) > 0 {
+		worstCase = "X (lost)"
+	}
+	if won > 0 {
+		fmt.Printf("Average guesses: %.3f | Worst case: %s\n", float64(totalGuesses)/float64(won), worstCase)
+	} else {
+		fmt.Printf("Worst case: %s\n", worstCase)
+	}
+	if len(lost) > maxListedLosses {
+		fmt.Printf("Lost: %s and %d more\n", strings.Join(lost[:maxListedLosses], ", "), len(lost)-maxListedLosses)
+	} else if len(lost) > 0 {
+		fmt.Printf("Lost: %s\n", strings.Join(lost, ", "))
+	}
+	fmt.Println()
+	fmt.Println("--- GUESS DISTRIBUTION ---")
+	most := len(lost)
+	for _, count := range distribution {
+		if count > most {
+			most = count
+		}
+	}
+	bar := func(count int) string {
+		if count == 0 {
+			return ""
+		}
+		return strings.Repeat("#", (count*histogramWidth+most-1)/most)
+	}
+	for i, count := range distribution {
+		fmt.Println(strings.TrimSpace(fmt.Sprintf("%d\t|\t%d\t%s", i+1, count, bar(count))))
+	}
+	fmt.Println(strings.TrimSpace(fmt.Sprintf("X\t|\t%d\t%s", len(lost), bar(len(lost)))))
+}
+
+func strategyNames() string {
+	names := make([]string, len(solver.Strategies))
+	for i, strategy := range solver.Strategies {
+		names[i] = strategy.Name
+	}
+	return strings.Join(names, ", ")
+}
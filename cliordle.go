@@ -2,292 +2,391 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
 	"flag"
 	"fmt"
-	"math"
+	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/boltdb/bolt"
+	"github.com/j985chen/cli-ordle/game"
+	"github.com/j985chen/cli-ordle/solver"
 	"github.com/j985chen/cli-ordle/words"
 )
 
-const colourGreen = "\033[42m %s \033[0m"
-const colourYellow = "\033[43m %s \033[0m"
-const colourOrange = "\033[48;5;202m %s \033[0m"
-const colourBlue = "\033[46m %s \033[0m"
-
-var db *bolt.DB
-
-type Player struct {
-	Played        float64    `json:"played"`
-	Won           float64    `json:"won"`
-	CurrStreak    float64    `json:"currStreak"`
-	LongestStreak float64    `json:"longestStreak"`
-	Distribution  [6]float64 `json:"stats"`
-	HiContrast    bool       `json:"hiContrast"`
-	HardMode      bool       `json:"hardMode"`
+const dateFormat = "2006-01-02"
+
+var reader = bufio.NewReader(os.Stdin)
+
+// resumeGame offers to continue a game that was interrupted before it finished,
+// reporting whether a game was played. A declined game is recorded as a loss
+func resumeGame(p *game.Player, tui bool) (bool, error) {
+	currGame, err := loadGame()
+	if err != nil || currGame == nil {
+		return false, err
+	}
+	if currGame.State() != game.Playing {
+		// older versions saved the stats of a finished game before archiving
+		// it, so a game interrupted in between has already been counted
+		return false, archiveGame(currGame, nil)
+	}
+	for {
+		fmt.Printf("You have an unfinished game (%d/%d guesses). Resume it? [Y/n]: ", len(currGame.Guesses), currGame.GuessLimit())
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			fmt.Println()
+			return false, fmt.Errorf("could not read answer: %v", err)
+		}
+		answer := strings.ToLower(strings.TrimSpace(input))
+		if answer == "" || answer == "y" || answer == "yes" {
+			return true, playGame(p, currGame, tui)
+		} else if answer == "n" || answer == "no" {
+			break
+		}
+	}
+	fmt.Printf("The answer was %s\n", currGame.Answer)
+	currGame.Abandon()
+	p.RecordGame(currGame)
+	return false, archiveGame(currGame, p)
 }
 
-func (p *Player) CreateGame() error {
-	answer, err := words.RandomWord()
-	if err != nil {
+func createGame(p *game.Player, daily bool, length int, maxGuesses int, tui bool) error {
+	if err := game.CheckGuessLimit(maxGuesses); err != nil {
+		return err
+	}
+	var answer string
+	opts := game.Options{HardMode: playerHardMode(p), MaxGuesses: maxGuesses}
+	var err error
+	now := time.Now()
+	today := now.Format(dateFormat)
+	if daily {
+		answer, opts.Puzzle, err = words.DailyWord(now, length)
+		if err != nil {
+			return err
+		}
+		if p.PlayedDaily(today, length) {
+			return fmt.Errorf("you have already played today's puzzle (#%d), use --daily=false for a random word", opts.Puzzle)
+		}
+	} else {
+		answer, err = words.RandomWord(length)
+		if err != nil {
+			return err
+		}
+	}
+	// save the game before any guess is read, so an interrupted game can
+	// always be resumed or counted as a loss
+	g := game.NewGame(answer, opts)
+	if err = saveGame(g); err != nil {
 		return err
 	}
-	currGame := Game{p, []Guess{}, answer, false}
-	err = currGame.PlayGame()
-	return err
+	if daily {
+		// mark the puzzle as played up front so that each player only gets one attempt
+		p.MarkDaily(today, length)
+		if err = savePlayer(p); err != nil {
+			return err
+		}
+	}
+	return playGame(p, g, tui)
 }
 
-func (p *Player) ManageSettings(hiContrast bool, hardMode bool) error {
+func manageSettings(p *game.Player, hiContrast bool, hardMode bool, themeName string, showCandidates bool) error {
+	if _, ok := findTheme(themeName); !ok && themeName != "" {
+		return fmt.Errorf("theme %q not found, choose one of %s", themeName, strings.Join(themeNames(), ", "))
+	}
 	p.HiContrast = hiContrast
-	p.HardMode = false
+	p.HardMode = hardMode
+	p.Theme = themeName
+	p.ShowCandidates = showCandidates
 	fmt.Println("---   CURRENT SETTINGS   ---")
-	fmt.Printf("High-contrast\t|\t%t\nHard mode\t|\t%t\n", p.HiContrast, p.HardMode)
-	return p.SaveStats()
-}
-
-func (p *Player) UpdateStatsW(numGuesses int) error {
-	p.CurrStreak++
-	p.LongestStreak = math.Max(p.CurrStreak, p.LongestStreak)
-	p.Distribution[numGuesses-1]++
-	p.Won++
-	p.Played++
-	return p.SaveStats()
-}
-
-func (p *Player) UpdateStatsL() error {
-	p.CurrStreak = 0
-	p.Played++
-	return p.SaveStats()
+	fmt.Printf("High-contrast\t|\t%t\nHard mode\t|\t%t\nTheme\t\t|\t%s\nShow candidates\t|\t%t\n", p.HiContrast, playerHardMode(p), playerThemeName(p), p.ShowCandidates)
+	if userConfig.HardMode != nil || userConfig.Theme != "" {
+		fmt.Println()
+		fmt.Println("Settings from the config file or CLIORDLE_* env vars override the saved ones")
+	}
+	return savePlayer(p)
 }
 
-func (p *Player) ViewStats() error {
-	var winPercent float64
-	if p.Played == 0 {
-		winPercent = 0
-	} else {
-		winPercent = (p.Won / p.Played) * 100
+func viewStats(p *game.Player, maxGuesses int) error {
+	if err := game.CheckGuessLimit(maxGuesses); err != nil {
+		return err
 	}
+	stats := p.StatsFor(maxGuesses)
 	fmt.Println("---     STATISTICS     ---")
-	fmt.Printf("Played: %.0f | Win%%: %.0f%% | Current streak: %.0f | Longest streak: %.0f\n", p.Played, winPercent, p.CurrStreak, p.LongestStreak)
+	if maxGuesses != game.DefaultMaxGuesses {
+		fmt.Printf("Games with %d guesses\n", maxGuesses)
+	}
+	fmt.Printf("Played: %d | Win%%: %.0f%% | Current streak: %d | Longest streak: %d\n", stats.Played, stats.WinPercent(), stats.Streak(time.Now()), stats.LongestStreak)
+	if stats.HintedWins > 0 {
+		fmt.Printf("Won with hints: %d of %d wins\n", stats.HintedWins, stats.Won)
+	}
 	fmt.Println()
 	fmt.Println("--- GUESS DISTRIBUTION ---")
-	for i := 0; i < 6; i++ {
-		fmt.Printf("%d\t|\t%.0f\n", i+1, p.Distribution[i])
+	for i := 0; i < maxGuesses; i++ {
+		fmt.Printf("%d\t|\t%d\n", i+1, stats.Distribution[i])
+	}
+
+	var otherLimits []int
+	if maxGuesses != game.DefaultMaxGuesses && p.Played > 0 {
+		otherLimits = append(otherLimits, game.DefaultMaxGuesses)
+	}
+	for limit := range p.Variants {
+		if limit != maxGuesses && p.Variants[limit].Played > 0 {
+			otherLimits = append(otherLimits, limit)
+		}
+	}
+	if len(otherLimits) > 0 {
+		sort.Ints(otherLimits)
+		fmt.Println()
+		fmt.Printf("Stats are also recorded for games with %s guesses, view them with --max-guesses\n", joinInts(otherLimits))
 	}
 	return nil
 }
 
-func (p *Player) SaveStats() error {
-	playerBytes, err := json.Marshal(*p)
+func shareLastGame(p *game.Player) error {
+	lastGame, err := loadLastGame()
 	if err != nil {
-		return fmt.Errorf("could not marshal player data json: %v", err)
+		return err
 	}
-	err = db.Update(func(tx *bolt.Tx) error {
-		err = tx.Bucket([]byte("DB")).Put([]byte("PLAYER"), playerBytes)
-		if err != nil {
-			return fmt.Errorf("could not set player data: %v", err)
-		}
-		return nil
-	})
-	return err
+	if lastGame == nil {
+		return fmt.Errorf("no finished game to share yet")
+	}
+	fmt.Println(lastGame.ShareText(shareHiContrast(p)))
+	return nil
 }
 
-type Guess struct {
-	Word     string
-	Answer   string
-	Statuses [5]string
+// handleResults prints the result of a finished game and records it in the
+// player's stats and the game history
+func handleResults(p *game.Player, g *game.Game) error {
+	if g.Solved {
+		fmt.Printf("Impressive! You got the word in %d guesses\n", len(g.Guesses))
+	} else {
+		fmt.Printf("The answer was %s\n", g.Answer)
+	}
+	p.RecordGame(g)
+	return archiveGame(g, p)
 }
 
-func (g *Guess) GetGuessStatuses() {
-	splitAns := strings.Split(g.Answer, "")
-	splitGuess := strings.Split(g.Word, "")
-	solutionCharsUsed := make([]bool, 5)
-	var statuses [5]string
-
-	for i, g := range splitGuess {
-		if g == splitAns[i] {
-			statuses[i] = "correct"
-			solutionCharsUsed[i] = true
-		}
+// playGame plays a game until it is finished, in the terminal UI if tui is set
+// or otherwise reading a line for each guess
+func playGame(p *game.Player, g *game.Game, tui bool) error {
+	if tui {
+		return playGameTUI(p, g)
 	}
-
-	for i, g := range splitGuess {
-		if statuses[i] != "" {
-			continue
+	printHeader(os.Stdout, g)
+	t := playerTheme(p)
+	if len(g.Guesses) > 0 {
+		printBoard(os.Stdout, g, t, "")
+		printKeyboard(os.Stdout, g, t)
+		if p.ShowCandidates && g.State() == game.Playing {
+			fmt.Println(candidatesRemaining(len(g.Candidates())))
 		}
-		if !find(splitAns, g) {
-			statuses[i] = "absent"
-		} else {
-			indexOfPresentChar := -1
-			for j, a := range splitAns {
-				if a == g && !solutionCharsUsed[j] {
-					indexOfPresentChar = j
-					break
+	}
+	for g.State() == game.Playing {
+		var wordErr error = game.ErrInvalidGuess
+		for wordErr != nil {
+			fmt.Printf("Guess %d/%d: ", len(g.Guesses)+1, g.GuessLimit())
+			input, err := reader.ReadString('\n')
+			if err != nil {
+				// the game is saved after every guess, so it can be resumed later
+				fmt.Println()
+				return fmt.Errorf("could not read guess: %v", err)
+			}
+			guess := strings.ToLower(strings.TrimSuffix(input, "\n"))
+			if isCommand(guess) {
+				message, err := runCommand(g, guess)
+				if err != nil {
+					fmt.Println(err)
+					continue
 				}
+				fmt.Println(message)
+				if err = saveGame(g); err != nil {
+					return err
+				}
+				continue
 			}
-			if indexOfPresentChar > -1 {
-				statuses[i] = "present"
-				solutionCharsUsed[indexOfPresentChar] = true
-			} else {
-				statuses[i] = "absent"
+			_, wordErr = g.Guess(guess)
+			if wordErr == game.ErrInvalidGuess {
+				fmt.Printf("%s is an invalid guess, try again (%s)\n", guess, commandHelp)
+			} else if wordErr != nil {
+				fmt.Printf("%s is not allowed in hard mode: %v\n", guess, wordErr)
 			}
 		}
+		if err := saveGame(g); err != nil {
+			return err
+		}
+		printBoard(os.Stdout, g, t, "")
+		printKeyboard(os.Stdout, g, t)
+		if p.ShowCandidates && g.State() == game.Playing {
+			fmt.Println(candidatesRemaining(len(g.Candidates())))
+		}
 	}
-	g.Statuses = statuses
+	return finishGame(p, g)
 }
 
-type Game struct {
-	Player  *Player
-	Guesses []Guess
-	Answer  string
-	Solved  bool
+func printHeader(w io.Writer, g *game.Game) {
+	if g.Puzzle > 0 {
+		fmt.Fprintf(w, "--- CLIORDLE DAILY #%d ---\n", g.Puzzle)
+	} else {
+		fmt.Fprintf(w, "--- START OF CLIORDLE GAME ---\n")
+	}
 }
 
-func (g *Game) ProcessGuess(guessedWord string) error {
-	isValid := words.IsValidGuess(guessedWord)
-	if !isValid {
-		return fmt.Errorf("invalid")
-	}
-	guess := Guess{}
-	guess.Word = guessedWord
-	guess.Answer = g.Answer
-	guess.GetGuessStatuses()
-	g.Guesses = append(g.Guesses, guess)
-	if guessedWord == g.Answer {
-		g.Solved = true
+// finishGame records the result of a finished game and prints its share grid
+func finishGame(p *game.Player, g *game.Game) error {
+	if err := handleResults(p, g); err != nil {
+		return err
 	}
+	fmt.Println()
+	fmt.Println(g.ShareText(shareHiContrast(p)))
+	fmt.Println()
+	fmt.Println("See how each guess compared with the solver's with the analyze subcommand")
 	return nil
 }
 
-func (g *Game) PrintBoard() error {
-	var placedColour string
-	var includesColour string
-	if g.Player.HiContrast {
-		placedColour = colourOrange
-		includesColour = colourBlue
-	} else {
-		placedColour = colourGreen
-		includesColour = colourYellow
-	}
-	fmt.Printf(" ___  ___  ___  ___  ___\n")
-	for i := 0; i < len(g.Guesses); i++ {
-		for j := 0; j < 5; j++ {
-			letter := string(g.Guesses[i].Word[j])
-
-			fmt.Printf("|")
-			if g.Guesses[i].Statuses[j] == "correct" {
-				fmt.Printf(string(placedColour), letter)
-			} else if g.Guesses[i].Statuses[j] == "present" {
-				fmt.Printf(string(includesColour), letter)
+// manageProfiles runs the profiles subcommand, which takes an action and,
+// except for list, a profile name
+func manageProfiles(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("list, create, delete, or default action required")
+	}
+	action := args[0]
+	if action == "list" {
+		names, err := listProfiles()
+		if err != nil {
+			return err
+		}
+		defaultName, err := getDefaultProfile()
+		if err != nil {
+			return err
+		}
+		fmt.Println("---       PROFILES       ---")
+		for _, name := range names {
+			if name == defaultName {
+				fmt.Printf("%s (default)\n", name)
 			} else {
-				fmt.Printf(" %s ", letter)
+				fmt.Println(name)
 			}
-			fmt.Printf("|")
 		}
-		fmt.Println("\n ---  ---  ---  ---  ---")
+		return nil
 	}
-	for i := len(g.Guesses); i < 6; i++ {
-		for j := 0; j < 5; j++ {
-			fmt.Printf("|   |")
+
+	if len(args) != 2 {
+		return fmt.Errorf("profiles %s requires a profile name", action)
+	}
+	name := args[1]
+	switch action {
+	case "create":
+		if strings.TrimSpace(name) != name || name == "" {
+			return fmt.Errorf("profile name cannot be empty or start or end with spaces")
 		}
-		fmt.Println("\n ---  ---  ---  ---  ---")
+		if err := createProfile(name); err != nil {
+			return err
+		}
+		fmt.Printf("Created profile %s\n", name)
+	case "delete":
+		defaultName, err := getDefaultProfile()
+		if err != nil {
+			return err
+		}
+		if name == defaultName {
+			return fmt.Errorf("cannot delete the default profile, set another default first")
+		}
+		if err = deleteProfile(name); err != nil {
+			return err
+		}
+		fmt.Printf("Deleted profile %s\n", name)
+	case "default":
+		exists, err := profileExists(name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("profile %q does not exist", name)
+		}
+		if err = setDefaultProfile(name); err != nil {
+			return err
+		}
+		fmt.Printf("%s is now the default profile\n", name)
+	default:
+		return fmt.Errorf("list, create, delete, or default action required")
 	}
-	fmt.Println()
 	return nil
 }
 
-func (g *Game) HandleResults() error {
-	if g.Solved {
-		numGuesses := len(g.Guesses)
-		fmt.Printf("Impressive! You got the word in %d guesses\n", numGuesses)
-		return g.Player.UpdateStatsW(numGuesses)
-
-	} else {
-		fmt.Printf("The answer was %s\n", g.Answer)
-		return g.Player.UpdateStatsL()
+func joinInts(nums []int) string {
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.Itoa(n)
 	}
+	return strings.Join(strs, ", ")
 }
 
-func (g *Game) PlayGame() error {
-	var err error
-	var input string
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("--- START OF CLIORDLE GAME ---\n")
-	for i := 1; i <= 6; i++ {
-		wordErr := fmt.Errorf("invalid")
-		for wordErr != nil {
-			fmt.Printf("Guess %d/6: ", i)
-			input, err = reader.ReadString('\n')
-			guess := strings.ToLower(strings.TrimSuffix(input, "\n"))
-			wordErr = g.ProcessGuess(guess)
-			if wordErr != nil {
-				fmt.Printf("%s is an invalid guess, try again\n", guess)
-			}
-		}
-		g.PrintBoard()
-		if g.Solved {
-			break
-		}
+func exitGracefully(err error) {
+	if db != nil {
+		db.Close()
 	}
-	g.HandleResults()
-	return err
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
 }
 
-func find(arr []string, str string) bool {
-	for _, s := range arr {
-		if s == str {
-			return true
-		}
+func main() {
+	// the config sets the defaults of the options below, so it is loaded first
+	if err := loadConfig(); err != nil {
+		exitGracefully(err)
 	}
-	return false
-}
 
-func setupDB() error {
-	var dbErr error
-	db, dbErr = bolt.Open("cliordle.db", 0600, nil)
+	// global option pointers
+	profilePtr := flag.String("profile", userConfig.Profile, "Name of the player profile to use, the default profile if not set\nin the config or CLIORDLE_PROFILE")
+	colourPtr := flag.String("color", "auto", "Whether to colour letters by their status: auto, always, or never.\nauto uses colour only when printing to a terminal and NO_COLOR is not set")
+	dbPtr := flag.String("db", userConfig.DB, "Path of the stats database, $XDG_DATA_HOME/cliordle/cliordle.db if not set\nin the config or CLIORDLE_DB")
 
-	if dbErr != nil {
-		return fmt.Errorf("could not open db, %v", dbErr)
+	// display usage info when user enters --help option
+	flag.Usage = func() {
+		fmt.Printf("Usage: %s [options] {play|settings|stats|share|history|analyze|export|import|profiles|solve|bench} [command options]\nOptions:\n", os.Args[0])
+		flag.PrintDefaults()
 	}
+	flag.Parse()
+	args := flag.Args()
 
-	dbErr = db.Update(func(tx *bolt.Tx) error {
-		_, bucketErr := tx.CreateBucketIfNotExists([]byte("DB"))
-		if bucketErr != nil {
-			return fmt.Errorf("could not create root bucket: %v", bucketErr)
-		}
-		return nil
-	})
-	if dbErr != nil {
-		return fmt.Errorf("could not set up buckets, %v", dbErr)
+	// validate that correct number of arguments is being received
+	if len(args) < 1 {
+		exitGracefully(fmt.Errorf("play, settings, stats, share, history, analyze, export, import, profiles, solve, or bench subcommand required"))
 	}
-	return nil
-}
 
-func initPlayer() (Player, error) {
-	var player Player
-	err := db.View(func(tx *bolt.Tx) error {
-		playerBytes := tx.Bucket([]byte("DB")).Get([]byte("PLAYER"))
-		var dbErr error = nil
-		if playerBytes != nil {
-			dbErr = json.Unmarshal(playerBytes, &player)
-		} else {
-			player = Player{0, 0, 0, 0, [6]float64{0}, false, false}
-		}
-		return dbErr
-	})
-	return player, err
-}
+	if err := setColourMode(*colourPtr); err != nil {
+		exitGracefully(err)
+	}
 
-func exitGracefully(err error) {
-	fmt.Fprintf(os.Stderr, "error: %v\n", err)
-	os.Exit(1)
-}
+	// the solver and benchmark don't use any player data
+	if args[0] == "solve" {
+		solveCommand := flag.NewFlagSet("solve", flag.ExitOnError)
+		solveLengthPtr := solveCommand.Int("length", words.DefaultLength, fmt.Sprintf("Number of letters in the word (%d-%d)", words.MinLength, words.MaxLength))
+		solveCommand.Parse(args[1:])
+		if err := solveGame(*solveLengthPtr); err != nil {
+			exitGracefully(err)
+		}
+		return
+	}
+	if args[0] == "bench" {
+		benchCommand := flag.NewFlagSet("bench", flag.ExitOnError)
+		var strategyHelp strings.Builder
+		strategyHelp.WriteString("Solver strategy to play with:")
+		for _, strategy := range solver.Strategies {
+			fmt.Fprintf(&strategyHelp, "\n%s: %s", strategy.Name, strategy.Description)
+		}
+		benchStrategyPtr := benchCommand.String("strategy", "entropy", strategyHelp.String())
+		benchLengthPtr := benchCommand.Int("length", words.DefaultLength, fmt.Sprintf("Number of letters in the words (%d-%d)", words.MinLength, words.MaxLength))
+		benchMaxGuessesPtr := benchCommand.Int("max-guesses", game.DefaultMaxGuesses, fmt.Sprintf("Number of guesses allowed (1-%d)", game.MaxGuessLimit))
+		benchCommand.Parse(args[1:])
+		if err := benchmark(*benchStrategyPtr, *benchLengthPtr, *benchMaxGuessesPtr); err != nil {
+			exitGracefully(err)
+		}
+		return
+	}
 
-func main() {
-	dbErr := setupDB()
+	dbErr := setupDB(*dbPtr)
 
 	if dbErr != nil {
 		exitGracefully(dbErr)
@@ -295,45 +394,117 @@ func main() {
 
 	defer db.Close()
 
-	// display usage info when user enters --help option
-	flag.Usage = func() {
-		fmt.Printf("Usage: %s [options] \nOptions:\n", os.Args[0])
-		flag.PrintDefaults()
+	if args[0] == "profiles" {
+		if err := manageProfiles(args[1:]); err != nil {
+			exitGracefully(err)
+		}
+		return
+	}
+
+	if err := useProfile(*profilePtr); err != nil {
+		exitGracefully(err)
 	}
 
 	player, err := initPlayer()
+	if err != nil {
+		exitGracefully(err)
+	}
 
 	// cliordle subcommands
 	playCommand := flag.NewFlagSet("play", flag.ExitOnError)
 	settingsCommand := flag.NewFlagSet("settings", flag.ExitOnError)
 	statsCommand := flag.NewFlagSet("stats", flag.ExitOnError)
+	shareCommand := flag.NewFlagSet("share", flag.ExitOnError)
+	historyCommand := flag.NewFlagSet("history", flag.ExitOnError)
+	analyzeCommand := flag.NewFlagSet("analyze", flag.ExitOnError)
+	exportCommand := flag.NewFlagSet("export", flag.ExitOnError)
+	importCommand := flag.NewFlagSet("import", flag.ExitOnError)
+
+	// play command flag pointers
+	playDailyPtr := playCommand.Bool("daily", userConfig.Mode != "random", "Play today's puzzle instead of a random word")
+	playMaxGuessesPtr := playCommand.Int("max-guesses", game.DefaultMaxGuesses, fmt.Sprintf("Number of guesses allowed (1-%d)", game.MaxGuessLimit))
+	playTUIPtr := playCommand.Bool("tui", false, "Play in a full-screen terminal UI with an on-screen keyboard")
+	defaultLength := words.DefaultLength
+	if userConfig.Length != 0 {
+		defaultLength = userConfig.Length
+	}
+	playLengthPtr := playCommand.Int("length", defaultLength, fmt.Sprintf("Number of letters in the word (%d-%d)", words.MinLength, words.MaxLength))
+
+	// stats command flag pointers
+	statsMaxGuessesPtr := statsCommand.Int("max-guesses", game.DefaultMaxGuesses, "Show stats for games with this many guesses")
+	statsRebuildPtr := statsCommand.Bool("rebuild", false, "Recompute stats from the game history, reporting any that are wrong")
+	statsFixPtr := statsCommand.Bool("fix", false, "With --rebuild, replace wrong stats with the recomputed ones")
+
+	// history command flag pointers
+	historyFromPtr := historyCommand.String("from", "", "Only show games finished on or after this date (YYYY-MM-DD)")
+	historyToPtr := historyCommand.String("to", "", "Only show games finished on or before this date (YYYY-MM-DD)")
+	historyResultPtr := historyCommand.String("result", "", "Only show games that were won or lost")
+
+	// analyze command flag pointers
+	analyzeGamePtr := analyzeCommand.Uint64("game", 0, "Number of the game in the history to analyze, the last game if not set")
+
+	// export command flag pointers
+	exportFormatPtr := exportCommand.String("format", "json", "Export format, json or csv (game history only)")
+	exportOutputPtr := exportCommand.String("output", "", "File to write the export to, stdout if not set")
 
 	// settings command flag pointers
 	settingsContrastPtr := settingsCommand.Bool("highContrast", player.HiContrast, "Turn high-contrast mode on/off")
 	settingsHardModePtr := settingsCommand.Bool("hardMode", player.HardMode, "Turn hard mode on/off")
+	settingsCandidatesPtr := settingsCommand.Bool("showCandidates", player.ShowCandidates, "Show how many possible answers remain after each guess")
+	settingsThemePtr := settingsCommand.String("theme", player.Theme, fmt.Sprintf("Colour theme, one of %s or a theme from the config file.\nIf not set, the high-contrast setting chooses the theme", strings.Join(themeNames(), ", ")))
 
-	// validate that correct number of arguments is being received
-	if len(os.Args) < 2 {
-		err = fmt.Errorf("play, settings, or stats subcommand required")
-	}
-
-	switch os.Args[1] {
+	switch args[0] {
 	case "play":
-		playCommand.Parse(os.Args[2:])
+		playCommand.Parse(args[1:])
 	case "settings":
-		settingsCommand.Parse(os.Args[2:])
+		settingsCommand.Parse(args[1:])
 	case "stats":
-		statsCommand.Parse(os.Args[2:])
+		statsCommand.Parse(args[1:])
+	case "share":
+		shareCommand.Parse(args[1:])
+	case "history":
+		historyCommand.Parse(args[1:])
+	case "analyze":
+		analyzeCommand.Parse(args[1:])
+	case "export":
+		exportCommand.Parse(args[1:])
+	case "import":
+		importCommand.Parse(args[1:])
 	default:
-		err = fmt.Errorf("play, settings, or stats subcommand required")
+		exitGracefully(fmt.Errorf("play, settings, stats, share, history, analyze, export, import, profiles, solve, or bench subcommand required"))
 	}
 
 	if playCommand.Parsed() {
-		err = player.CreateGame()
+		var resumed bool
+		if *playTUIPtr {
+			err = checkTerminal()
+		}
+		if err == nil {
+			resumed, err = resumeGame(&player, *playTUIPtr)
+		}
+		if err == nil && !resumed {
+			err = createGame(&player, *playDailyPtr, *playLengthPtr, *playMaxGuessesPtr, *playTUIPtr)
+		}
 	} else if settingsCommand.Parsed() {
-		err = player.ManageSettings(*settingsContrastPtr, *settingsHardModePtr)
+		err = manageSettings(&player, *settingsContrastPtr, *settingsHardModePtr, *settingsThemePtr, *settingsCandidatesPtr)
+	} else if shareCommand.Parsed() {
+		err = shareLastGame(&player)
+	} else if historyCommand.Parsed() {
+		err = viewHistory(*historyFromPtr, *historyToPtr, *historyResultPtr)
+	} else if analyzeCommand.Parsed() {
+		err = analyzeGame(*analyzeGamePtr)
+	} else if exportCommand.Parsed() {
+		err = exportData(&player, *exportFormatPtr, *exportOutputPtr)
+	} else if importCommand.Parsed() {
+		if importCommand.NArg() != 1 {
+			err = fmt.Errorf("import requires the file to import")
+		} else {
+			err = importData(&player, importCommand.Arg(0))
+		}
+	} else if *statsRebuildPtr {
+		err = rebuildStats(&player, *statsFixPtr)
 	} else {
-		err = player.ViewStats()
+		err = viewStats(&player, *statsMaxGuessesPtr)
 	}
 
 	if err != nil {
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+func TestResumeFinishedGame(t *testing.T) {
+	openTestDB(t)
+	// a game whose stats were saved by an older version that was interrupted
+	// before it could archive the game
+	g := game.NewGame("cigar", game.Options{})
+	if _, err := g.Guess("cigar"); err != nil {
+		t.Fatal(err)
+	}
+	p := game.Player{}
+	p.RecordGame(g)
+	if err := savePlayer(&p); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveGame(g); err != nil {
+		t.Fatal(err)
+	}
+
+	played, err := resumeGame(&p, false)
+	if err != nil || played {
+		t.Fatalf("resumeGame = %t, %v, want the finished game to be archived", played, err)
+	}
+	if stored, err := initPlayer(); err != nil || stored.Played != 1 {
+		t.Errorf("finished game was counted %d times (%v)", stored.Played, err)
+	}
+	if saved, err := loadGame(); err != nil || saved != nil {
+		t.Errorf("finished game is still saved as the game in progress (%v)", err)
+	}
+	entries, err := loadHistory()
+	if err != nil || len(entries) != 1 {
+		t.Errorf("history has %d games, want the finished game (%v)", len(entries), err)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+const commandHelp = "commands are :hint to reveal a letter, :hint word to suggest a word and :candidates to list the possible answers"
+
+// the most possible answers that :candidates lists
+const maxCommandCandidates = 20
+
+// isCommand reports whether input is an in-game command rather than a guess
+func isCommand(input string) bool {
+	return strings.HasPrefix(input, ":")
+}
+
+// runCommand runs an in-game command, returning the message to show. Hints are
+// recorded on the game, so it should be saved afterwards
+func runCommand(g *game.Game, input string) (string, error) {
+	switch strings.Join(strings.Fields(input), " ") {
+	case ":hint":
+		hint, err := g.RevealHint()
+		return hint.Text, err
+	case ":hint word":
+		hint, err := g.WordHint()
+		return hint.Text, err
+	case ":candidates":
+		candidates := g.Candidates()
+		if len(candidates) > maxCommandCandidates {
+			return fmt.Sprintf("%s, too many to list", candidatesRemaining(len(candidates))), nil
+		}
+		return fmt.Sprintf("%s: %s", candidatesRemaining(len(candidates)), strings.Join(candidates, ", ")), nil
+	}
+	return "", fmt.Errorf("unknown command %s, %s", strings.TrimSpace(input), commandHelp)
+}
+
+func candidatesRemaining(n int) string {
+	if n == 1 {
+		return "1 possible answer remains"
+	}
+	return fmt.Sprintf("%d possible answers remain", n)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// config holds the user's defaults from the config file. They override the
+// settings stored for the player, and are overridden by CLIORDLE_* env vars
+// and then by command line options
+type config struct {
+	Mode     string `json:"mode,omitempty"` // daily or random
+	Length   int    `json:"length,omitempty"`
+	HardMode *bool  `json:"hardMode,omitempty"`
+	Theme    string `json:"theme,omitempty"`
+	DB       string `json:"db,omitempty"`
+	Profile  string `json:"profile,omitempty"`
+	// themes defined by the user, which can override the built-in ones
+	Themes map[string]theme `json:"themes,omitempty"`
+}
+
+// userConfig is the loaded config file with env vars applied, set by loadConfig
+var userConfig config
+
+// configPath returns the path of the config file, from the CLIORDLE_CONFIG
+// env var or in the user config dir
+func configPath() (string, error) {
+	if envPath := os.Getenv("CLIORDLE_CONFIG"); envPath != "" {
+		return envPath, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find config dir: %v", err)
+	}
+	return filepath.Join(dir, "cliordle", "config.json"), nil
+}
+
+// loadConfig reads the config file into userConfig and applies any env vars
+// over it. It is fine for there to be no config file
+func loadConfig() error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not read config file: %v", err)
+	} else if err == nil {
+		if err = json.Unmarshal(data, &userConfig); err != nil {
+			return fmt.Errorf("could not parse config file %s: %v", path, err)
+		}
+	}
+	for name, t := range userConfig.Themes {
+		if err = t.validate(); err != nil {
+			return fmt.Errorf("theme %q in config file: %v", name, err)
+		}
+	}
+	if err = userConfig.applyEnv(); err != nil {
+		return err
+	}
+	if userConfig.Mode != "" && userConfig.Mode != "daily" && userConfig.Mode != "random" {
+		return fmt.Errorf("mode should be daily or random, not %q", userConfig.Mode)
+	}
+	if _, ok := findTheme(userConfig.Theme); !ok && userConfig.Theme != "" {
+		return fmt.Errorf("theme %q not found", userConfig.Theme)
+	}
+	return nil
+}
+
+func (c *config) applyEnv() error {
+	if mode := os.Getenv("CLIORDLE_MODE"); mode != "" {
+		c.Mode = mode
+	}
+	if length := os.Getenv("CLIORDLE_LENGTH"); length != "" {
+		n, err := strconv.Atoi(length)
+		if err != nil {
+			return fmt.Errorf("CLIORDLE_LENGTH should be a number, not %q", length)
+		}
+		c.Length = n
+	}
+	if hardMode := os.Getenv("CLIORDLE_HARD_MODE"); hardMode != "" {
+		b, err := strconv.ParseBool(hardMode)
+		if err != nil {
+			return fmt.Errorf("CLIORDLE_HARD_MODE should be true or false, not %q", hardMode)
+		}
+		c.HardMode = &b
+	}
+	if themeName := os.Getenv("CLIORDLE_THEME"); themeName != "" {
+		c.Theme = themeName
+	}
+	if dbPath := os.Getenv("CLIORDLE_DB"); dbPath != "" {
+		c.DB = dbPath
+	}
+	if profileName := os.Getenv("CLIORDLE_PROFILE"); profileName != "" {
+		c.Profile = profileName
+	}
+	return nil
+}
+
+// playerHardMode returns whether new games are played in hard mode, which the
+// config can override
+func playerHardMode(p *game.Player) bool {
+	if userConfig.HardMode != nil {
+		return *userConfig.HardMode
+	}
+	return p.HardMode
+}
@@ -0,0 +1,453 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/j985chen/cli-ordle/game"
+)
+
+const defaultProfile = "default"
+
+var db *bolt.DB
+
+// name of the profile whose player data & games are being used
+var profile string
+
+// dataPath returns the db location from the --db flag, which defaults to the
+// CLIORDLE_DB env var or the config, or else the XDG data directory. Only the
+// XDG location is reported as the default location
+func dataPath(flagPath string) (string, bool, error) {
+	if flagPath != "" {
+		return flagPath, false, nil
+	}
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", false, fmt.Errorf("could not find home directory for db: %v", err)
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "cliordle", "cliordle.db"), true, nil
+}
+
+// migrateLocalDB moves a db created in the working directory by older versions
+// to path, unless a db already exists there
+func migrateLocalDB(path string) error {
+	const localPath = "cliordle.db"
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(localPath); err != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create db directory: %v", err)
+	}
+	if err := os.Rename(localPath, path); err != nil {
+		// renaming fails across filesystems, so fall back to copying
+		if err = copyFile(localPath, path); err != nil {
+			return fmt.Errorf("could not move %s to %s: %v", localPath, path, err)
+		}
+		if err = os.Remove(localPath); err != nil {
+			return fmt.Errorf("could not remove %s after copying it to %s: %v", localPath, path, err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "moved %s to %s\n", localPath, path)
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	return out.Close()
+}
+
+func setupDB(flagPath string) error {
+	path, isDefault, err := dataPath(flagPath)
+	if err != nil {
+		return err
+	}
+	if isDefault {
+		if err = migrateLocalDB(path); err != nil {
+			return err
+		}
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create db directory: %v", err)
+	}
+
+	var dbErr error
+	db, dbErr = bolt.Open(path, 0600, nil)
+
+	if dbErr != nil {
+		return fmt.Errorf("could not open db, %v", dbErr)
+	}
+
+	dbErr = db.Update(func(tx *bolt.Tx) error {
+		_, bucketErr := tx.CreateBucketIfNotExists([]byte("DB"))
+		if bucketErr != nil {
+			return fmt.Errorf("could not create root bucket: %v", bucketErr)
+		}
+		_, bucketErr = tx.CreateBucketIfNotExists([]byte("PROFILES"))
+		if bucketErr != nil {
+			return fmt.Errorf("could not create profiles bucket: %v", bucketErr)
+		}
+		if err := migrateLegacyPlayer(tx); err != nil {
+			return err
+		}
+		return migratePlayers(tx)
+	})
+	if dbErr != nil {
+		return fmt.Errorf("could not set up buckets, %v", dbErr)
+	}
+	return nil
+}
+
+// migrateLegacyPlayer moves the player & games saved before profiles existed
+// into the default profile
+func migrateLegacyPlayer(tx *bolt.Tx) error {
+	root := tx.Bucket([]byte("DB"))
+	if root.Get([]byte("PLAYER")) == nil {
+		return nil
+	}
+	bucket, err := tx.Bucket([]byte("PROFILES")).CreateBucketIfNotExists([]byte(defaultProfile))
+	if err != nil {
+		return fmt.Errorf("could not create default profile: %v", err)
+	}
+	for _, key := range []string{"PLAYER", "GAME", "LAST_GAME"} {
+		value := root.Get([]byte(key))
+		if value == nil {
+			continue
+		}
+		if err = bucket.Put([]byte(key), append([]byte{}, value...)); err != nil {
+			return fmt.Errorf("could not migrate %s: %v", key, err)
+		}
+		if err = root.Delete([]byte(key)); err != nil {
+			return fmt.Errorf("could not migrate %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func profileBucket(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket([]byte("PROFILES")).Bucket([]byte(profile))
+}
+
+// useProfile selects the profile used by the rest of the program, falling back
+// to the default profile when name is empty. The default profile is created on
+// first use, while any other profile must already exist
+func useProfile(name string) error {
+	if name == "" {
+		var err error
+		if name, err = getDefaultProfile(); err != nil {
+			return err
+		}
+	}
+	exists, err := profileExists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if name != defaultProfile {
+			return fmt.Errorf("profile %q does not exist, create it with `profiles create %s`", name, name)
+		}
+		if err = createProfile(name); err != nil {
+			return err
+		}
+	}
+	profile = name
+	return nil
+}
+
+func profileExists(name string) (bool, error) {
+	var exists bool
+	err := db.View(func(tx *bolt.Tx) error {
+		exists = tx.Bucket([]byte("PROFILES")).Bucket([]byte(name)) != nil
+		return nil
+	})
+	return exists, err
+}
+
+func listProfiles() ([]string, error) {
+	var names []string
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte("PROFILES")).ForEach(func(k, v []byte) error {
+			// nested buckets have no value
+			if v == nil {
+				names = append(names, string(k))
+			}
+			return nil
+		})
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+func createProfile(name string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.Bucket([]byte("PROFILES")).CreateBucket([]byte(name))
+		if err == bolt.ErrBucketExists {
+			return fmt.Errorf("profile %q already exists", name)
+		} else if err != nil {
+			return fmt.Errorf("could not create profile %q: %v", name, err)
+		}
+		return nil
+	})
+}
+
+func deleteProfile(name string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket([]byte("PROFILES")).DeleteBucket([]byte(name))
+		if err == bolt.ErrBucketNotFound {
+			return fmt.Errorf("profile %q does not exist", name)
+		} else if err != nil {
+			return fmt.Errorf("could not delete profile %q: %v", name, err)
+		}
+		return nil
+	})
+}
+
+func getDefaultProfile() (string, error) {
+	name := defaultProfile
+	err := db.View(func(tx *bolt.Tx) error {
+		if stored := tx.Bucket([]byte("DB")).Get([]byte("DEFAULT_PROFILE")); stored != nil {
+			name = string(stored)
+		}
+		return nil
+	})
+	return name, err
+}
+
+func setDefaultProfile(name string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket([]byte("DB")).Put([]byte("DEFAULT_PROFILE"), []byte(name))
+		if err != nil {
+			return fmt.Errorf("could not set default profile: %v", err)
+		}
+		return nil
+	})
+}
+
+func initPlayer() (game.Player, error) {
+	var player game.Player
+	err := db.View(func(tx *bolt.Tx) error {
+		playerBytes := profileBucket(tx).Get([]byte("PLAYER"))
+		var dbErr error = nil
+		if playerBytes != nil {
+			player, dbErr = decodePlayer(playerBytes)
+		}
+		return dbErr
+	})
+	return player, err
+}
+
+func savePlayer(p *game.Player) error {
+	playerBytes, err := encodePlayer(p)
+	if err != nil {
+		return err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		err = profileBucket(tx).Put([]byte("PLAYER"), playerBytes)
+		if err != nil {
+			return fmt.Errorf("could not set player data: %v", err)
+		}
+		return nil
+	})
+	return err
+}
+
+func saveGame(g *game.Game) error {
+	gameBytes, err := json.Marshal(*g)
+	if err != nil {
+		return fmt.Errorf("could not marshal game json: %v", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		err = profileBucket(tx).Put([]byte("GAME"), gameBytes)
+		if err != nil {
+			return fmt.Errorf("could not save game: %v", err)
+		}
+		return nil
+	})
+	return err
+}
+
+// archiveGame adds g to the game history and replaces the last finished game
+// with it, which also clears it as the game in progress. The player, if not
+// nil, is saved in the same transaction so that a finished game is never
+// counted in the stats while still saved as the game in progress
+func archiveGame(g *game.Game, p *game.Player) error {
+	gameBytes, err := json.Marshal(*g)
+	if err != nil {
+		return fmt.Errorf("could not marshal game json: %v", err)
+	}
+	var playerBytes []byte
+	if p != nil {
+		if playerBytes, err = encodePlayer(p); err != nil {
+			return err
+		}
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket := profileBucket(tx)
+		if playerBytes != nil {
+			if err := bucket.Put([]byte("PLAYER"), playerBytes); err != nil {
+				return fmt.Errorf("could not set player data: %v", err)
+			}
+		}
+		history, err := bucket.CreateBucketIfNotExists([]byte("HISTORY"))
+		if err != nil {
+			return fmt.Errorf("could not create history bucket: %v", err)
+		}
+		id, err := history.NextSequence()
+		if err != nil {
+			return fmt.Errorf("could not get history id: %v", err)
+		}
+		if err := history.Put(historyKey(id), gameBytes); err != nil {
+			return fmt.Errorf("could not add game to history: %v", err)
+		}
+		if err := bucket.Put([]byte("LAST_GAME"), gameBytes); err != nil {
+			return fmt.Errorf("could not save finished game: %v", err)
+		}
+		if err := bucket.Delete([]byte("GAME")); err != nil {
+			return fmt.Errorf("could not clear saved game: %v", err)
+		}
+		return nil
+	})
+	return err
+}
+
+// loadGame returns the unfinished game saved in the db, or nil if there is none
+func loadGame() (*game.Game, error) {
+	return loadGameKey("GAME")
+}
+
+// loadLastGame returns the most recently finished game, or nil if there is none
+func loadLastGame() (*game.Game, error) {
+	return loadGameKey("LAST_GAME")
+}
+
+func loadGameKey(key string) (*game.Game, error) {
+	var g *game.Game
+	err := db.View(func(tx *bolt.Tx) error {
+		gameBytes := profileBucket(tx).Get([]byte(key))
+		if gameBytes == nil {
+			return nil
+		}
+		g = &game.Game{}
+		if err := json.Unmarshal(gameBytes, g); err != nil {
+			return fmt.Errorf("could not unmarshal saved game: %v", err)
+		}
+		return nil
+	})
+	return g, err
+}
+
+// loadHistoryGame returns the game with the given id in the history, or nil if
+// there is none
+func loadHistoryGame(id uint64) (*game.Game, error) {
+	var g *game.Game
+	err := db.View(func(tx *bolt.Tx) error {
+		history := profileBucket(tx).Bucket([]byte("HISTORY"))
+		if history == nil {
+			return nil
+		}
+		gameBytes := history.Get(historyKey(id))
+		if gameBytes == nil {
+			return nil
+		}
+		g = &game.Game{}
+		if err := json.Unmarshal(gameBytes, g); err != nil {
+			return fmt.Errorf("could not unmarshal game %d: %v", id, err)
+		}
+		return nil
+	})
+	return g, err
+}
+
+// historyEntry is a finished game recorded in the history bucket
+type historyEntry struct {
+	ID   uint64
+	Game *game.Game
+}
+
+// history keys are big-endian ids so that bolt keeps games in the order played
+func historyKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func loadHistory() ([]historyEntry, error) {
+	var entries []historyEntry
+	err := db.View(func(tx *bolt.Tx) error {
+		history := profileBucket(tx).Bucket([]byte("HISTORY"))
+		if history == nil {
+			return nil
+		}
+		return history.ForEach(func(k, v []byte) error {
+			g := &game.Game{}
+			if err := json.Unmarshal(v, g); err != nil {
+				return fmt.Errorf("could not unmarshal game %d: %v", binary.BigEndian.Uint64(k), err)
+			}
+			entries = append(entries, historyEntry{binary.BigEndian.Uint64(k), g})
+			return nil
+		})
+	})
+	// imported games can be older than ones already recorded
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Game.FinishedAt.Before(entries[j].Game.FinishedAt)
+	})
+	return entries, err
+}
+
+// saveImport adds imported games to the history and saves the merged player
+// in a single transaction, so that a failed import changes nothing
+func saveImport(p *game.Player, games []*game.Game) error {
+	playerBytes, err := encodePlayer(p)
+	if err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := profileBucket(tx)
+		history, err := bucket.CreateBucketIfNotExists([]byte("HISTORY"))
+		if err != nil {
+			return fmt.Errorf("could not create history bucket: %v", err)
+		}
+		for _, g := range games {
+			gameBytes, err := json.Marshal(*g)
+			if err != nil {
+				return fmt.Errorf("could not marshal game json: %v", err)
+			}
+			id, err := history.NextSequence()
+			if err != nil {
+				return fmt.Errorf("could not get history id: %v", err)
+			}
+			if err = history.Put(historyKey(id), gameBytes); err != nil {
+				return fmt.Errorf("could not add game to history: %v", err)
+			}
+		}
+		if err = bucket.Put([]byte("PLAYER"), playerBytes); err != nil {
+			return fmt.Errorf("could not set player data: %v", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// exportFile is the json export format. The player is stored raw so that
+// files exported by older versions can be migrated like stored players
+type exportFile struct {
+	Version int             `json:"version"`
+	Player  json.RawMessage `json:"player,omitempty"`
+	Games   []*game.Game    `json:"games"`
+}
+
+var csvHeader = []string{"started_at", "finished_at", "answer", "result", "guesses", "length", "max_guesses", "hard_mode", "puzzle", "abandoned", "hints"}
+
+// exportData writes the player's stats and game history to output, or stdout
+// if output is empty. The csv format only includes the game history
+func exportData(p *game.Player, format string, output string) error {
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("--format should be json or csv")
+	}
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	games := make([]*game.Game, len(entries))
+	for i, entry := range entries {
+		games[i] = entry.Game
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("could not create export file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if format == "json" {
+		playerBytes, err := json.Marshal(*p)
+		if err != nil {
+			return fmt.Errorf("could not marshal player data json: %v", err)
+		}
+		exportBytes, err := json.MarshalIndent(exportFile{playerVersion, playerBytes, games}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not marshal export json: %v", err)
+		}
+		_, err = fmt.Fprintln(w, string(exportBytes))
+		return err
+	}
+
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Write(csvHeader)
+	for _, g := range games {
+		result := "lost"
+		if g.State() == game.Won {
+			result = "won"
+		}
+		guesses := make([]string, len(g.Guesses))
+		for i, guess := range g.Guesses {
+			guesses[i] = guess.Word
+		}
+		csvWriter.Write([]string{
+			g.StartedAt.Format(time.RFC3339),
+			g.FinishedAt.Format(time.RFC3339),
+			g.Answer,
+			result,
+			strings.Join(guesses, " "),
+			strconv.Itoa(g.Length()),
+			strconv.Itoa(g.GuessLimit()),
+			strconv.FormatBool(g.HardMode),
+			strconv.Itoa(g.Puzzle),
+			strconv.FormatBool(g.Abandoned),
+			strconv.Itoa(len(g.Hints)),
+		})
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// importData merges the stats and games in a json or csv export into the
+// player's. Games already in the history are skipped, and the stats of each
+// new game are added to the player's. Stats for games that were never recorded
+// in a history can't be told apart from ones already counted, so they are only
+// imported into a player with no stats of their own
+func importData(p *game.Player, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read import file: %v", err)
+	}
+	var filePlayer *game.Player
+	var games []*game.Game
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		filePlayer, games, err = parseJSONExport(data)
+	} else {
+		games, err = parseCSVExport(data)
+	}
+	if err != nil {
+		return err
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		seen[gameKey(entry.Game)] = true
+	}
+	var newGames []*game.Game
+	for _, g := range games {
+		if !seen[gameKey(g)] {
+			seen[gameKey(g)] = true
+			newGames = append(newGames, g)
+		}
+	}
+	sort.SliceStable(newGames, func(i, j int) bool {
+		return newGames[i].FinishedAt.Before(newGames[j].FinishedAt)
+	})
+
+	adoptStats := filePlayer != nil && !p.HasPlayed()
+	if adoptStats {
+		p.Stats = filePlayer.Stats
+		p.Variants = filePlayer.Variants
+	} else {
+		imported := game.Player{}
+		for _, g := range newGames {
+			imported.RecordGame(g)
+		}
+		if filePlayer != nil {
+			// the file's longest streaks may cover games missing from its history
+			imported.LongestStreak = maxInt(imported.LongestStreak, filePlayer.LongestStreak)
+			for limit, stats := range filePlayer.Variants {
+				importedStats := imported.StatsFor(limit)
+				importedStats.LongestStreak = maxInt(importedStats.LongestStreak, stats.LongestStreak)
+			}
+		}
+		p.MergeStats(&imported)
+	}
+	if err = saveImport(p, newGames); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d new games (%d already recorded)\n", len(newGames), len(games)-len(newGames))
+	if adoptStats {
+		fmt.Println("Imported stats")
+	} else if filePlayer != nil {
+		fmt.Println("Merged stats of the imported games")
+	}
+	return nil
+}
+
+func parseJSONExport(data []byte) (*game.Player, []*game.Game, error) {
+	var file exportFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, nil, fmt.Errorf("could not unmarshal import json: %v", err)
+	}
+	var filePlayer *game.Player
+	if len(file.Player) > 0 {
+		envelopeBytes, err := json.Marshal(playerEnvelope{file.Version, file.Player})
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read imported player: %v", err)
+		}
+		player, err := decodePlayer(envelopeBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = player.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid player stats: %v", err)
+		}
+		filePlayer = &player
+	}
+	for i, g := range file.Games {
+		if g == nil {
+			return nil, nil, fmt.Errorf("game %d is empty", i+1)
+		}
+		if err := g.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid game %d: %v", i+1, err)
+		}
+		g.Rescore()
+	}
+	return filePlayer, file.Games, nil
+}
+
+func parseCSVExport(data []byte) ([]*game.Game, error) {
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("could not read import csv: %v", err)
+	}
+	// exports from before hints were added have no hints column
+	if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(csvHeader, ",") &&
+		strings.Join(records[0], ",") != strings.Join(csvHeader[:len(csvHeader)-1], ",") {
+		return nil, fmt.Errorf("import csv should start with the header %s", strings.Join(csvHeader, ","))
+	}
+	var games []*game.Game
+	for i, record := range records[1:] {
+		g, err := parseCSVGame(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid game on line %d: %v", i+2, err)
+		}
+		games = append(games, g)
+	}
+	return games, nil
+}
+
+func parseCSVGame(record []string) (*game.Game, error) {
+	g := &game.Game{Answer: record[2]}
+	var err error
+	if g.StartedAt, err = time.Parse(time.RFC3339, record[0]); err != nil {
+		return nil, fmt.Errorf("started_at: %v", err)
+	}
+	if g.FinishedAt, err = time.Parse(time.RFC3339, record[1]); err != nil {
+		return nil, fmt.Errorf("finished_at: %v", err)
+	}
+	for _, word := range strings.Fields(record[4]) {
+		g.Guesses = append(g.Guesses, game.Guess{Word: word})
+	}
+	g.Solved = len(g.Guesses) > 0 && g.Guesses[len(g.Guesses)-1].Word == g.Answer
+	if length, err := strconv.Atoi(record[5]); err != nil || length != g.Length() {
+		return nil, fmt.Errorf("length %q does not match the answer", record[5])
+	}
+	if g.MaxGuesses, err = strconv.Atoi(record[6]); err != nil || g.MaxGuesses < 1 {
+		return nil, fmt.Errorf("max_guesses %q should be a positive number", record[6])
+	}
+	if g.HardMode, err = strconv.ParseBool(record[7]); err != nil {
+		return nil, fmt.Errorf("hard_mode: %v", err)
+	}
+	if g.Puzzle, err = strconv.Atoi(record[8]); err != nil {
+		return nil, fmt.Errorf("puzzle: %v", err)
+	}
+	if g.Abandoned, err = strconv.ParseBool(record[9]); err != nil {
+		return nil, fmt.Errorf("abandoned: %v", err)
+	}
+	if len(record) > 10 {
+		// only the number of hints is exported, not what they were
+		hints, err := strconv.Atoi(record[10])
+		if err != nil || hints < 0 {
+			return nil, fmt.Errorf("hints %q should be a number", record[10])
+		}
+		g.Hints = make([]game.Hint, hints)
+	}
+	g.Rescore()
+	if err = g.Validate(); err != nil {
+		return nil, err
+	}
+	if result := record[3]; (result == "won") != g.Solved || (result != "won" && result != "lost") {
+		return nil, fmt.Errorf("result %q does not match the guesses", result)
+	}
+	return g, nil
+}
+
+// gameKey identifies a game across exports, to the second since csv exports
+// don't keep fractional seconds
+func gameKey(g *game.Game) string {
+	return g.StartedAt.UTC().Format(time.RFC3339) + " " + g.Answer
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// openTestDB sets up an empty db in a temporary directory and uses its default profile
+func openTestDB(t *testing.T) {
+	t.Helper()
+	if err := setupDB(filepath.Join(t.TempDir(), "cliordle.db")); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := useProfile(""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// importCSV imports csv rows, which are prefixed with the csv header, into p
+func importCSV(t *testing.T, p *game.Player, rows ...string) error {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "import.csv")
+	data := strings.Join(append([]string{strings.Join(csvHeader, ",")}, rows...), "\n")
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return importData(p, path)
+}
+
+func TestImportMatchesRebuild(t *testing.T) {
+	openTestDB(t)
+	p := game.Player{}
+	err := importCSV(t, &p,
+		"2022-03-01T12:00:00Z,2022-03-01T12:05:00Z,cigar,won,crane cigar,5,6,false,0,false,0",
+		"2022-03-02T12:00:00Z,2022-03-02T12:05:00Z,rebut,lost,crane moist lofty bumpy dully jumpy,5,6,false,0,false,0",
+		"2022-03-03T12:00:00Z,2022-03-03T12:05:00Z,sissy,won,sissy,5,6,false,0,false,0",
+		"2022-03-04T12:00:00Z,2022-03-04T12:05:00Z,humph,won,crane humph,5,6,false,0,false,0",
+	)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if p.CurrStreak != 2 || p.LongestStreak != 2 {
+		t.Errorf("streaks after import are %d and %d, want 2 and 2", p.CurrStreak, p.LongestStreak)
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rebuilt := game.Player{}
+	for _, entry := range entries {
+		rebuilt.RecordGame(entry.Game)
+	}
+	for _, diff := range diffStats(p.StatsFor(game.DefaultMaxGuesses), rebuilt.StatsFor(game.DefaultMaxGuesses)) {
+		t.Errorf("imported stats differ from the history: %s", diff)
+	}
+}
+
+func TestImportRejectsNonAnswers(t *testing.T) {
+	openTestDB(t)
+	p := game.Player{}
+	// aahed is a valid guess but can never be an answer
+	err := importCSV(t, &p, "2022-03-01T12:00:00Z,2022-03-01T12:05:00Z,aahed,won,aahed,5,6,false,0,false,0")
+	if err == nil || !strings.Contains(err.Error(), `"aahed" is not a valid answer`) {
+		t.Errorf("import of a game with answer aahed = %v, want an invalid answer error", err)
+	}
+	entries, err := loadHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 || p.HasPlayed() {
+		t.Errorf("rejected import recorded %d games", len(entries))
+	}
+}
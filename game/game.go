@@ -0,0 +1,415 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/j985chen/cli-ordle/score"
+	"github.com/j985chen/cli-ordle/solver"
+	"github.com/j985chen/cli-ordle/words"
+)
+
+const DefaultMaxGuesses = 6
+
+// MaxGuessLimit is the most guesses a game can allow
+const MaxGuessLimit = 20
+
+var ErrInvalidGuess = errors.New("invalid guess")
+var ErrGameOver = errors.New("game is already over")
+
+// State describes whether a game is still being played
+type State int
+
+const (
+	Playing State = iota
+	Won
+	Lost
+)
+
+type Guess struct {
+	Word     string               `json:"word"`
+	Statuses []score.LetterStatus `json:"statuses"`
+}
+
+// Result is the outcome of a single accepted guess
+type Result struct {
+	Guess Guess
+	State State
+}
+
+// Hint is help given during a game
+type Hint struct {
+	AfterGuesses int    `json:"afterGuesses"` // number of guesses made before the hint
+	Text         string `json:"text"`
+	// what a revealing hint gave away: a letter, and its position from 1 if known
+	Letter   string `json:"letter,omitempty"`
+	Position int    `json:"position,omitempty"`
+}
+
+// CheckGuessLimit returns an error if a game can't allow maxGuesses guesses
+func CheckGuessLimit(maxGuesses int) error {
+	if maxGuesses < 1 || maxGuesses > MaxGuessLimit {
+		return fmt.Errorf("max guesses must be between 1 and %d", MaxGuessLimit)
+	}
+	return nil
+}
+
+// Options configure a new game
+type Options struct {
+	Puzzle     int // daily puzzle number, 0 for a random word
+	HardMode   bool
+	MaxGuesses int // DefaultMaxGuesses if 0
+}
+
+type Game struct {
+	Guesses  []Guess `json:"guesses"`
+	Answer   string  `json:"answer"`
+	Solved   bool    `json:"solved"`
+	Puzzle   int     `json:"puzzle,omitempty"`
+	HardMode bool    `json:"hardMode"`
+	// games saved before the guess limit was configurable have none stored
+	MaxGuesses int       `json:"maxGuesses,omitempty"`
+	Abandoned  bool      `json:"abandoned,omitempty"`
+	Hints      []Hint    `json:"hints,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+func NewGame(answer string, opts Options) *Game {
+	if opts.MaxGuesses <= 0 {
+		opts.MaxGuesses = DefaultMaxGuesses
+	}
+	return &Game{
+		Guesses:    []Guess{},
+		Answer:     answer,
+		Puzzle:     opts.Puzzle,
+		HardMode:   opts.HardMode,
+		MaxGuesses: opts.MaxGuesses,
+		StartedAt:  time.Now(),
+	}
+}
+
+// Guess scores a word against the answer and adds it to the game. Words that
+// are not in the dictionary are rejected with ErrInvalidGuess, and in hard mode
+// words that ignore a revealed hint are rejected with the reason why
+func (g *Game) Guess(word string) (Result, error) {
+	if g.State() != Playing {
+		return Result{}, ErrGameOver
+	}
+	if len(word) != len(g.Answer) || !words.IsValidGuess(word) {
+		return Result{}, ErrInvalidGuess
+	}
+	if g.HardMode {
+		if err := g.checkHardMode(word); err != nil {
+			return Result{}, err
+		}
+	}
+	guess := Guess{word, score.Score(word, g.Answer)}
+	g.Guesses = append(g.Guesses, guess)
+	if word == g.Answer {
+		g.Solved = true
+	}
+	state := g.State()
+	if state != Playing {
+		g.FinishedAt = time.Now()
+	}
+	return Result{guess, state}, nil
+}
+
+// Validate checks that a finished game could have been played as recorded: the
+// answer is one of the possible answers, every guess is a valid word scored correctly against the answer, and the
+// game ended when the answer was guessed or the guesses ran out
+func (g *Game) Validate() error {
+	if !words.IsAnswer(g.Answer) {
+		return fmt.Errorf("%q is not a valid answer", g.Answer)
+	}
+	if g.MaxGuesses != 0 {
+		if err := CheckGuessLimit(g.MaxGuesses); err != nil {
+			return err
+		}
+	}
+	if len(g.Guesses) > g.GuessLimit() {
+		return fmt.Errorf("%d guesses made when only %d were allowed", len(g.Guesses), g.GuessLimit())
+	}
+	for i, guess := range g.Guesses {
+		if len(guess.Word) != g.Length() || !words.IsValidGuess(guess.Word) {
+			return fmt.Errorf("guess %q is not a valid word", guess.Word)
+		}
+		statuses := score.Score(guess.Word, g.Answer)
+		if guess.Statuses != nil && !equalStatuses(guess.Statuses, statuses) {
+			return fmt.Errorf("guess %q is scored incorrectly", guess.Word)
+		}
+		if guess.Word == g.Answer && i != len(g.Guesses)-1 {
+			return fmt.Errorf("guesses continue after the answer was found")
+		}
+	}
+	solved := len(g.Guesses) > 0 && g.Guesses[len(g.Guesses)-1].Word == g.Answer
+	if solved != g.Solved {
+		return fmt.Errorf("solved is %t but the answer was guessed: %t", g.Solved, solved)
+	}
+	if g.State() == Playing {
+		return fmt.Errorf("game is not finished")
+	}
+	if g.FinishedAt.IsZero() {
+		return fmt.Errorf("game has no finish time")
+	}
+	for _, hint := range g.Hints {
+		if hint.AfterGuesses < 0 || hint.AfterGuesses > len(g.Guesses) {
+			return fmt.Errorf("hint given after %d guesses when %d were made", hint.AfterGuesses, len(g.Guesses))
+		}
+	}
+	return nil
+}
+
+// Rescore recomputes the status of every guess from the answer
+func (g *Game) Rescore() {
+	for i := range g.Guesses {
+		g.Guesses[i].Statuses = score.Score(g.Guesses[i].Word, g.Answer)
+	}
+}
+
+func equalStatuses(a []score.LetterStatus, b []score.LetterStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// LetterStatuses returns the best status revealed so far for each guessed
+// letter, so a letter found in the right place anywhere is Correct
+func (g *Game) LetterStatuses() map[byte]score.LetterStatus {
+	statuses := map[byte]score.LetterStatus{}
+	for _, guess := range g.Guesses {
+		for i, status := range guess.Statuses {
+			letter := guess.Word[i]
+			if status > statuses[letter] {
+				statuses[letter] = status
+			}
+		}
+	}
+	return statuses
+}
+
+// RevealHint gives a hint about the answer that hasn't been revealed yet by a
+// guess or an earlier hint: the place of a letter known to be in the word, or
+// else a letter not yet known, or else the place of any letter not yet found.
+// If everything has been revealed, an error is returned and no hint is recorded
+func (g *Game) RevealHint() (Hint, error) {
+	if g.State() != Playing {
+		return Hint{}, ErrGameOver
+	}
+	found := make([]bool, g.Length())
+	guessed := map[byte]bool{}
+	known := map[byte]bool{} // letters known to be in the answer
+	for _, guess := range g.Guesses {
+		for i, status := range guess.Statuses {
+			if status == score.Correct {
+				found[i] = true
+			}
+			if status == score.Correct || status == score.Present {
+				known[guess.Word[i]] = true
+			}
+			guessed[guess.Word[i]] = true
+		}
+	}
+	for _, hint := range g.Hints {
+		if hint.Position > 0 && hint.Position <= g.Length() {
+			found[hint.Position-1] = true
+		}
+		if hint.Letter != "" {
+			guessed[hint.Letter[0]] = true
+			known[hint.Letter[0]] = true
+		}
+	}
+
+	position := 0
+	for i := range found {
+		if !found[i] && known[g.Answer[i]] {
+			position = i + 1
+			break
+		}
+	}
+	if position == 0 {
+		for i := 0; i < g.Length(); i++ {
+			if !guessed[g.Answer[i]] {
+				letter := strings.ToUpper(string(g.Answer[i]))
+				return g.addHint(Hint{Text: fmt.Sprintf("The word contains %s", letter), Letter: string(g.Answer[i])}), nil
+			}
+		}
+		for i := range found {
+			if !found[i] {
+				position = i + 1
+				break
+			}
+		}
+	}
+	if position == 0 {
+		return Hint{}, fmt.Errorf("every letter has already been revealed")
+	}
+	letter := g.Answer[position-1]
+	text := fmt.Sprintf("The %s letter is %s", ordinal(position), strings.ToUpper(string(letter)))
+	return g.addHint(Hint{Text: text, Letter: string(letter), Position: position}), nil
+}
+
+// WordHint suggests the possible answer that is expected to narrow down the
+// rest the most, which is always allowed in hard mode
+func (g *Game) WordHint() (Hint, error) {
+	if g.State() != Playing {
+		return Hint{}, ErrGameOver
+	}
+	candidates := g.Candidates()
+	best := solver.Rank(candidates, candidates, 1)
+	if len(best) == 0 {
+		return Hint{}, fmt.Errorf("no possible answers match the guesses")
+	}
+	text := fmt.Sprintf("Try %s, one of %d possible answers", strings.ToUpper(best[0].Word), len(candidates))
+	if len(candidates) == 1 {
+		text = fmt.Sprintf("Try %s, the only possible answer", strings.ToUpper(best[0].Word))
+	}
+	return g.addHint(Hint{Text: text}), nil
+}
+
+func (g *Game) addHint(hint Hint) Hint {
+	hint.AfterGuesses = len(g.Guesses)
+	g.Hints = append(g.Hints, hint)
+	return hint
+}
+
+// Candidates returns the answers that match every guess made so far
+func (g *Game) Candidates() []string {
+	candidates, err := words.Answers(g.Length())
+	if err != nil {
+		return nil
+	}
+	for _, guess := range g.Guesses {
+		candidates = solver.Filter(candidates, guess.Word, guess.Statuses)
+	}
+	return candidates
+}
+
+// Abandon gives up on an unfinished game, which counts as a loss
+func (g *Game) Abandon() {
+	if g.State() == Playing {
+		g.Abandoned = true
+		g.FinishedAt = time.Now()
+	}
+}
+
+// Length returns the number of letters in the answer
+func (g *Game) Length() int {
+	return len(g.Answer)
+}
+
+// GuessLimit returns the number of guesses allowed before the game is lost
+func (g *Game) GuessLimit() int {
+	if g.MaxGuesses <= 0 {
+		return DefaultMaxGuesses
+	}
+	return g.MaxGuesses
+}
+
+func (g *Game) State() State {
+	if g.Solved {
+		return Won
+	} else if g.Abandoned || len(g.Guesses) >= g.GuessLimit() {
+		return Lost
+	}
+	return Playing
+}
+
+// checkHardMode ensures that a guess reuses every hint revealed so far: letters
+// marked correct must stay in place and letters marked present must appear
+func (g *Game) checkHardMode(guessedWord string) error {
+	for _, prev := range g.Guesses {
+		for i, status := range prev.Statuses {
+			if status == score.Correct && guessedWord[i] != prev.Word[i] {
+				return fmt.Errorf("%s letter must be %s", ordinal(i+1), strings.ToUpper(string(prev.Word[i])))
+			}
+		}
+		remaining := []byte(guessedWord)
+		for i, status := range prev.Statuses {
+			if status == score.Correct {
+				remaining[i] = 0
+			}
+		}
+		for i, status := range prev.Statuses {
+			if status != score.Present {
+				continue
+			}
+			j := strings.IndexByte(string(remaining), prev.Word[i])
+			if j < 0 {
+				return fmt.Errorf("guess must contain %s", strings.ToUpper(string(prev.Word[i])))
+			}
+			remaining[j] = 0
+		}
+	}
+	return nil
+}
+
+// ShareText renders the game as an emoji grid that can be pasted into chat
+func (g *Game) ShareText(hiContrast bool) string {
+	placedSquare, includesSquare := "🟩", "🟨"
+	if hiContrast {
+		placedSquare, includesSquare = "🟧", "🟦"
+	}
+	var sb strings.Builder
+	sb.WriteString("cliordle")
+	if g.Puzzle > 0 {
+		fmt.Fprintf(&sb, " #%d", g.Puzzle)
+	}
+	if g.Solved {
+		fmt.Fprintf(&sb, " %d/%d", len(g.Guesses), g.GuessLimit())
+	} else {
+		fmt.Fprintf(&sb, " X/%d", g.GuessLimit())
+	}
+	if g.HardMode {
+		sb.WriteString("*")
+	}
+	var notes []string
+	if g.Length() != words.DefaultLength {
+		notes = append(notes, fmt.Sprintf("%d letters", g.Length()))
+	}
+	if len(g.Hints) == 1 {
+		notes = append(notes, "1 hint")
+	} else if len(g.Hints) > 1 {
+		notes = append(notes, fmt.Sprintf("%d hints", len(g.Hints)))
+	}
+	if len(notes) > 0 {
+		fmt.Fprintf(&sb, " (%s)", strings.Join(notes, ", "))
+	}
+	sb.WriteString("\n")
+	for _, guess := range g.Guesses {
+		sb.WriteString("\n")
+		for _, status := range guess.Statuses {
+			switch status {
+			case score.Correct:
+				sb.WriteString(placedSquare)
+			case score.Present:
+				sb.WriteString(includesSquare)
+			default:
+				sb.WriteString("⬛")
+			}
+		}
+	}
+	return sb.String()
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
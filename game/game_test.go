@@ -0,0 +1,45 @@
+package game
+
+import "testing"
+
+func TestHardMode(t *testing.T) {
+	tests := []struct {
+		answer  string
+		guesses []string // accepted before the checked guess
+		guess   string
+		want    string // error message, or "" if the guess is allowed
+	}{
+		{"heard", []string{"board"}, "bland", "4th letter must be R"},
+		{"heard", []string{"board"}, "blurt", "3rd letter must be A"},
+		{"heard", []string{"crane"}, "blast", "guess must contain R"},
+		{"heard", []string{"crane"}, "brash", "guess must contain E"},
+		{"heard", []string{"crane"}, "trade", ""},
+		{"heard", []string{"board"}, "heard", ""},
+		// a letter present twice must be reused twice
+		{"eerie", []string{"steel"}, "entry", "guess must contain E"},
+	}
+	for _, test := range tests {
+		g := NewGame(test.answer, Options{HardMode: true})
+		for _, guess := range test.guesses {
+			if _, err := g.Guess(guess); err != nil {
+				t.Fatalf("%s: guess %s was rejected: %v", test.answer, guess, err)
+			}
+		}
+		_, err := g.Guess(test.guess)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != test.want {
+			t.Errorf("%s after %v: Guess(%q) error = %q, want %q", test.answer, test.guesses, test.guess, got, test.want)
+		}
+	}
+}
+
+func TestHardModeOff(t *testing.T) {
+	g := NewGame("heard", Options{})
+	g.Guess("board")
+	if _, err := g.Guess("bland"); err != nil {
+		t.Errorf("Guess(%q) without hard mode error = %v, want nil", "bland", err)
+	}
+}
@@ -0,0 +1,254 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/j985chen/cli-ordle/words"
+)
+
+// Stats are the aggregate results of games played with the same guess limit
+type Stats struct {
+	Played        int   `json:"played"`
+	Won           int   `json:"won"`
+	CurrStreak    int   `json:"currStreak"`
+	LongestStreak int   `json:"longestStreak"`
+	Distribution  []int `json:"stats"`
+	HintedWins    int   `json:"hintedWins,omitempty"` // wins in games where hints were used
+	// local dates of the last game played and won, which streaks are counted by
+	LastPlayed string `json:"lastPlayed,omitempty"`
+	LastWon    string `json:"lastWon,omitempty"`
+}
+
+const dateFormat = "2006-01-02"
+
+// Player holds a player's settings and aggregate stats
+type Player struct {
+	Stats // games with the default number of guesses
+	// games with any other number of guesses, keyed by the guess limit
+	Variants   map[int]*Stats `json:"variants,omitempty"`
+	HiContrast bool           `json:"hiContrast"`
+	HardMode   bool           `json:"hardMode"`
+	// whether to show how many possible answers remain after each guess
+	ShowCandidates bool `json:"showCandidates,omitempty"`
+	// name of the colour theme, chosen by HiContrast if not set
+	Theme     string `json:"theme,omitempty"`
+	LastDaily string `json:"lastDaily,omitempty"`
+	// dates of the last daily puzzle played for word lengths other than the default
+	LastDailyLengths map[int]string `json:"lastDailyLengths,omitempty"`
+}
+
+// PlayedDaily reports whether the daily puzzle for the given date and word
+// length has already been played
+func (p *Player) PlayedDaily(date string, length int) bool {
+	if length == words.DefaultLength {
+		return p.LastDaily == date
+	}
+	return p.LastDailyLengths[length] == date
+}
+
+func (p *Player) MarkDaily(date string, length int) {
+	if length == words.DefaultLength {
+		p.LastDaily = date
+		return
+	}
+	if p.LastDailyLengths == nil {
+		p.LastDailyLengths = map[int]string{}
+	}
+	p.LastDailyLengths[length] = date
+}
+
+// StatsFor returns the stats for games with the given guess limit, creating
+// them if none have been played yet
+func (p *Player) StatsFor(maxGuesses int) *Stats {
+	var stats *Stats
+	if maxGuesses == DefaultMaxGuesses {
+		stats = &p.Stats
+	} else {
+		if p.Variants == nil {
+			p.Variants = map[int]*Stats{}
+		}
+		if p.Variants[maxGuesses] == nil {
+			p.Variants[maxGuesses] = &Stats{}
+		}
+		stats = p.Variants[maxGuesses]
+	}
+	for len(stats.Distribution) < maxGuesses {
+		stats.Distribution = append(stats.Distribution, 0)
+	}
+	return stats
+}
+
+// RecordGame updates the stats for the guess limit of a finished game, on the
+// day in the player's timezone that it was finished
+func (p *Player) RecordGame(g *Game) {
+	finished := g.FinishedAt
+	if finished.IsZero() {
+		finished = time.Now()
+	}
+	date := finished.Local().Format(dateFormat)
+	stats := p.StatsFor(g.GuessLimit())
+	if g.State() == Won {
+		stats.UpdateStatsW(len(g.Guesses), date)
+		if len(g.Hints) > 0 {
+			stats.HintedWins++
+		}
+	} else {
+		stats.UpdateStatsL(date)
+	}
+}
+
+// UpdateStatsW records a win on the given date. Streaks count consecutive days
+// with a win, so further wins on the same day don't extend the streak
+func (s *Stats) UpdateStatsW(numGuesses int, date string) {
+	if s.CurrStreak == 0 || s.LastWon == previousDay(date) || s.LastWon == "" {
+		// stats saved before dates were recorded continue their streak
+		s.CurrStreak++
+	} else if s.LastWon != date {
+		s.CurrStreak = 1
+	}
+	s.LastWon = date
+	s.LastPlayed = date
+	if s.CurrStreak > s.LongestStreak {
+		s.LongestStreak = s.CurrStreak
+	}
+	for len(s.Distribution) < numGuesses {
+		s.Distribution = append(s.Distribution, 0)
+	}
+	s.Distribution[numGuesses-1]++
+	s.Won++
+	s.Played++
+}
+
+// UpdateStatsL records a loss on the given date, which ends the streak
+func (s *Stats) UpdateStatsL(date string) {
+	s.CurrStreak = 0
+	s.LastPlayed = date
+	s.Played++
+}
+
+// Streak returns the current streak as of now, which is broken once a day
+// passes without a win
+func (s *Stats) Streak(now time.Time) int {
+	today := now.Local().Format(dateFormat)
+	if s.LastWon == "" || s.LastWon == today || s.LastWon == previousDay(today) {
+		return s.CurrStreak
+	}
+	return 0
+}
+
+func previousDay(date string) string {
+	t, err := time.Parse(dateFormat, date)
+	if err != nil {
+		return ""
+	}
+	return t.AddDate(0, 0, -1).Format(dateFormat)
+}
+
+// HasPlayed reports whether any games have been counted in the player's stats
+func (p *Player) HasPlayed() bool {
+	if p.Played > 0 {
+		return true
+	}
+	for _, stats := range p.Variants {
+		if stats.Played > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeStats adds the counts of every guess limit in other to the player's
+// stats. The longest streaks are kept, and the current streak is taken from
+// whichever stats have the latest win
+func (p *Player) MergeStats(other *Player) {
+	p.StatsFor(DefaultMaxGuesses).merge(other.StatsFor(DefaultMaxGuesses))
+	for limit, stats := range other.Variants {
+		p.StatsFor(limit).merge(stats)
+	}
+}
+
+func (s *Stats) merge(other *Stats) {
+	lastWon, streak := s.LastWon, s.CurrStreak
+	if other.LastWon > lastWon || other.LastWon == lastWon && other.CurrStreak > streak {
+		lastWon, streak = other.LastWon, other.CurrStreak
+	}
+	// a loss on either side after the latest win ends the streak
+	if s.lostSince(lastWon) || other.lostSince(lastWon) {
+		streak = 0
+	}
+	s.LastWon, s.CurrStreak = lastWon, streak
+	s.Played += other.Played
+	s.Won += other.Won
+	s.HintedWins += other.HintedWins
+	if other.LongestStreak > s.LongestStreak {
+		s.LongestStreak = other.LongestStreak
+	}
+	if other.LastPlayed > s.LastPlayed {
+		s.LastPlayed = other.LastPlayed
+	}
+	for i, count := range other.Distribution {
+		if i >= len(s.Distribution) {
+			s.Distribution = append(s.Distribution, 0)
+		}
+		s.Distribution[i] += count
+	}
+}
+
+// lostSince reports whether the last game counted was a loss after the given date
+func (s *Stats) lostSince(date string) bool {
+	return s.Played > 0 && s.CurrStreak == 0 && s.LastPlayed > date
+}
+
+// Validate checks that the player's stats are consistent with each other
+func (p *Player) Validate() error {
+	if err := p.Stats.validate(DefaultMaxGuesses); err != nil {
+		return fmt.Errorf("stats for %d guesses: %v", DefaultMaxGuesses, err)
+	}
+	for limit, stats := range p.Variants {
+		if CheckGuessLimit(limit) != nil || stats == nil {
+			return fmt.Errorf("invalid stats for %d guesses", limit)
+		}
+		if err := stats.validate(limit); err != nil {
+			return fmt.Errorf("stats for %d guesses: %v", limit, err)
+		}
+	}
+	return nil
+}
+
+func (s *Stats) validate(maxGuesses int) error {
+	if s.Played < 0 || s.Won < 0 || s.CurrStreak < 0 || s.LongestStreak < 0 || s.HintedWins < 0 {
+		return fmt.Errorf("counts cannot be negative")
+	}
+	if s.Won > s.Played {
+		return fmt.Errorf("won %d of only %d games", s.Won, s.Played)
+	}
+	if s.HintedWins > s.Won {
+		return fmt.Errorf("won %d games with hints of only %d wins", s.HintedWins, s.Won)
+	}
+	if s.CurrStreak > s.LongestStreak {
+		return fmt.Errorf("current streak is longer than the longest streak")
+	}
+	if len(s.Distribution) > maxGuesses {
+		return fmt.Errorf("guess distribution has more than %d entries", maxGuesses)
+	}
+	distributionWins := 0
+	for _, count := range s.Distribution {
+		if count < 0 {
+			return fmt.Errorf("counts cannot be negative")
+		}
+		distributionWins += count
+	}
+	if distributionWins != s.Won {
+		return fmt.Errorf("guess distribution adds up to %d wins instead of %d", distributionWins, s.Won)
+	}
+	return nil
+}
+
+// WinPercent returns the percentage of played games that were won
+func (s *Stats) WinPercent() float64 {
+	if s.Played == 0 {
+		return 0
+	}
+	return (float64(s.Won) / float64(s.Played)) * 100
+}
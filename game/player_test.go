@@ -0,0 +1,36 @@
+package game
+
+import "testing"
+
+func TestMergeStreaks(t *testing.T) {
+	tests := []struct {
+		name        string
+		stats       Stats
+		other       Stats
+		wantStreak  int
+		wantLastWon string
+	}{
+		{"into empty stats", Stats{},
+			Stats{Played: 1, Won: 1, CurrStreak: 1, LongestStreak: 1, LastPlayed: "2022-03-01", LastWon: "2022-03-01"},
+			1, "2022-03-01"},
+		{"other won later",
+			Stats{Played: 3, Won: 3, CurrStreak: 3, LongestStreak: 3, LastPlayed: "2022-03-03", LastWon: "2022-03-03"},
+			Stats{Played: 2, Won: 2, CurrStreak: 2, LongestStreak: 2, LastPlayed: "2022-03-05", LastWon: "2022-03-05"},
+			2, "2022-03-05"},
+		{"other won earlier",
+			Stats{Played: 3, Won: 3, CurrStreak: 3, LongestStreak: 3, LastPlayed: "2022-03-03", LastWon: "2022-03-03"},
+			Stats{Played: 2, Won: 2, CurrStreak: 2, LongestStreak: 2, LastPlayed: "2022-03-01", LastWon: "2022-03-01"},
+			3, "2022-03-03"},
+		{"other lost after the latest win",
+			Stats{Played: 3, Won: 3, CurrStreak: 3, LongestStreak: 3, LastPlayed: "2022-03-03", LastWon: "2022-03-03"},
+			Stats{Played: 2, Won: 1, CurrStreak: 0, LongestStreak: 1, LastPlayed: "2022-03-04", LastWon: "2022-03-01"},
+			0, "2022-03-03"},
+	}
+	for _, test := range tests {
+		test.stats.merge(&test.other)
+		if test.stats.CurrStreak != test.wantStreak || test.stats.LastWon != test.wantLastWon {
+			t.Errorf("%s: streak %d since %s, want %d since %s", test.name,
+				test.stats.CurrStreak, test.stats.LastWon, test.wantStreak, test.wantLastWon)
+		}
+	}
+}
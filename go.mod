@@ -2,6 +2,9 @@ module github.com/j985chen/cli-ordle
 
 go 1.17
 
-require github.com/boltdb/bolt v1.3.1
+require (
+	github.com/boltdb/bolt v1.3.1
+	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
+)
 
 require golang.org/x/sys v0.0.0-20220422013727-9388b58f7150 // indirect
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// viewHistory lists finished games, optionally only those finished within a
+// date range or with a given result
+func viewHistory(from string, to string, result string) error {
+	var fromDate, toDate time.Time
+	var err error
+	if from != "" {
+		if fromDate, err = time.ParseInLocation(dateFormat, from, time.Local); err != nil {
+			return fmt.Errorf("--from should be a date like 2022-01-31: %v", err)
+		}
+	}
+	if to != "" {
+		if toDate, err = time.ParseInLocation(dateFormat, to, time.Local); err != nil {
+			return fmt.Errorf("--to should be a date like 2022-01-31: %v", err)
+		}
+		// include every game finished on the last day
+		toDate = toDate.AddDate(0, 0, 1)
+	}
+	if result != "" && result != "won" && result != "lost" {
+		return fmt.Errorf("--result should be won or lost")
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+
+	var shown []historyEntry
+	for _, entry := range entries {
+		g := entry.Game
+		if !fromDate.IsZero() && g.FinishedAt.Before(fromDate) {
+			continue
+		}
+		if !toDate.IsZero() && !g.FinishedAt.Before(toDate) {
+			continue
+		}
+		won := g.State() == game.Won
+		if (result == "won" && !won) || (result == "lost" && won) {
+			continue
+		}
+		shown = append(shown, entry)
+	}
+
+	fmt.Println("---       HISTORY        ---")
+	if len(shown) == 0 {
+		fmt.Println("No games found")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tFinished\tAnswer\tResult\tTime\tMode\tGuesses")
+	for _, entry := range shown {
+		g := entry.Game
+		outcome := fmt.Sprintf("X/%d", g.GuessLimit())
+		if g.State() == game.Won {
+			outcome = fmt.Sprintf("%d/%d", len(g.Guesses), g.GuessLimit())
+		}
+		guesses := make([]string, len(g.Guesses))
+		for i, guess := range g.Guesses {
+			guesses[i] = guess.Word
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", entry.ID, g.FinishedAt.Local().Format("2006-01-02 15:04"),
+			g.Answer, outcome, gameDuration(g), gameMode(g), strings.Join(guesses, " "))
+	}
+	return w.Flush()
+}
+
+func gameDuration(g *game.Game) string {
+	if g.StartedAt.IsZero() || g.FinishedAt.IsZero() {
+		return "-"
+	}
+	return g.FinishedAt.Sub(g.StartedAt).Round(time.Second).String()
+}
+
+// gameMode describes the options a game was played with
+func gameMode(g *game.Game) string {
+	var mode []string
+	if g.Puzzle > 0 {
+		mode = append(mode, fmt.Sprintf("daily #%d", g.Puzzle))
+	} else {
+		mode = append(mode, "random")
+	}
+	mode = append(mode, fmt.Sprintf("%d letters", g.Length()))
+	if g.HardMode {
+		mode = append(mode, "hard")
+	}
+	if len(g.Hints) == 1 {
+		mode = append(mode, "1 hint")
+	} else if len(g.Hints) > 1 {
+		mode = append(mode, fmt.Sprintf("%d hints", len(g.Hints)))
+	}
+	if g.Abandoned {
+		mode = append(mode, "abandoned")
+	}
+	return strings.Join(mode, ", ")
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/boltdb/bolt"
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// playerVersion is the current version of the stored player json. Players
+// saved before versioning are stored without an envelope and are version 0
+const playerVersion = 1
+
+// playerEnvelope wraps the stored player json with the version it was saved at
+type playerEnvelope struct {
+	Version int             `json:"version"`
+	Player  json.RawMessage `json:"player"`
+}
+
+// playerMigrations[i] upgrades a decoded player from version i to version i+1
+var playerMigrations = []func(player map[string]interface{}) error{
+	migrateCountsToInts,
+}
+
+// migrateCountsToInts rounds the stat counters, which used to be float64s
+func migrateCountsToInts(player map[string]interface{}) error {
+	convertStats := func(stats map[string]interface{}) error {
+		for _, key := range []string{"played", "won", "currStreak", "longestStreak"} {
+			if value, ok := stats[key]; ok {
+				count, ok := value.(float64)
+				if !ok {
+					return fmt.Errorf("%s should be a number, got %v", key, value)
+				}
+				stats[key] = int(math.Round(count))
+			}
+		}
+		if value, ok := stats["stats"]; ok && value != nil {
+			distribution, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("stats should be a list, got %v", value)
+			}
+			for i, value := range distribution {
+				count, ok := value.(float64)
+				if !ok {
+					return fmt.Errorf("stats should be a list of numbers, got %v", value)
+				}
+				distribution[i] = int(math.Round(count))
+			}
+		}
+		return nil
+	}
+
+	if err := convertStats(player); err != nil {
+		return err
+	}
+	if variants, ok := player["variants"].(map[string]interface{}); ok {
+		for limit, value := range variants {
+			stats, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("stats for %s guesses should be an object", limit)
+			}
+			if err := convertStats(stats); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// upgradePlayer runs every migration needed to bring stored player json up
+// to the current version, reporting whether any were run
+func upgradePlayer(data []byte) (json.RawMessage, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, false, fmt.Errorf("could not unmarshal player data: %v", err)
+	}
+	var envelope playerEnvelope
+	if _, ok := fields["version"]; ok {
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			return nil, false, fmt.Errorf("could not unmarshal player data: %v", err)
+		}
+	} else {
+		// data may point into the read-only db mmap, so it is copied before
+		// anything can append to it
+		envelope.Player = append(json.RawMessage{}, data...)
+	}
+	if envelope.Version > playerVersion {
+		return nil, false, fmt.Errorf("player data is version %d, which is newer than this version of cliordle supports", envelope.Version)
+	}
+	if envelope.Version == playerVersion {
+		return envelope.Player, false, nil
+	}
+
+	var player map[string]interface{}
+	if err := json.Unmarshal(envelope.Player, &player); err != nil {
+		return nil, false, fmt.Errorf("could not unmarshal player data: %v", err)
+	}
+	for version := envelope.Version; version < playerVersion; version++ {
+		if err := playerMigrations[version](player); err != nil {
+			return nil, false, fmt.Errorf("could not migrate player data to version %d: %v", version+1, err)
+		}
+	}
+	playerBytes, err := json.Marshal(player)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not marshal migrated player data: %v", err)
+	}
+	return playerBytes, true, nil
+}
+
+func decodePlayer(data []byte) (game.Player, error) {
+	var player game.Player
+	playerBytes, _, err := upgradePlayer(data)
+	if err != nil {
+		return player, err
+	}
+	if err = json.Unmarshal(playerBytes, &player); err != nil {
+		return player, fmt.Errorf("could not unmarshal player data: %v", err)
+	}
+	return player, nil
+}
+
+func encodePlayer(p *game.Player) ([]byte, error) {
+	playerBytes, err := json.Marshal(*p)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal player data json: %v", err)
+	}
+	return json.Marshal(playerEnvelope{playerVersion, playerBytes})
+}
+
+// migratePlayers upgrades the stored player of every profile to the current version
+func migratePlayers(tx *bolt.Tx) error {
+	profiles := tx.Bucket([]byte("PROFILES"))
+	return profiles.ForEach(func(name, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		bucket := profiles.Bucket(name)
+		data := bucket.Get([]byte("PLAYER"))
+		if data == nil {
+			return nil
+		}
+		playerBytes, upgraded, err := upgradePlayer(data)
+		if err != nil {
+			return fmt.Errorf("profile %q: %v", name, err)
+		}
+		if !upgraded {
+			return nil
+		}
+		envelopeBytes, err := json.Marshal(playerEnvelope{playerVersion, playerBytes})
+		if err != nil {
+			return fmt.Errorf("profile %q: could not marshal player data: %v", name, err)
+		}
+		if err = bucket.Put([]byte("PLAYER"), envelopeBytes); err != nil {
+			return fmt.Errorf("profile %q: could not save migrated player data: %v", name, err)
+		}
+		return nil
+	})
+}
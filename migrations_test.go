@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// a player as saved by the first version, before profiles or versioning,
+// when the counters were float64s marshalled without a fraction
+const playerBaseline = `{"played":3,"won":2,"currStreak":1,"longestStreak":2,"stats":[0,1,1,0,0,0],"hiContrast":true,"hardMode":false}`
+
+func TestMigrateCountsToInts(t *testing.T) {
+	player := map[string]interface{}{
+		"played":        2.9999999,
+		"won":           2.0000001,
+		"currStreak":    1.0,
+		"longestStreak": 1.5,
+		"stats":         []interface{}{0.0, 0.9999, 1.2},
+		"hiContrast":    true,
+		"variants": map[string]interface{}{
+			"8": map[string]interface{}{"played": 1.0000001, "won": 0.9999999, "stats": []interface{}{0.0, 0.9999999}},
+		},
+	}
+	if err := migrateCountsToInts(player); err != nil {
+		t.Fatalf("migrateCountsToInts: %v", err)
+	}
+	want := map[string]interface{}{
+		"played":        3,
+		"won":           2,
+		"currStreak":    1,
+		"longestStreak": 2,
+		"stats":         []interface{}{0, 1, 1},
+		"hiContrast":    true,
+		"variants": map[string]interface{}{
+			"8": map[string]interface{}{"played": 1, "won": 1, "stats": []interface{}{0, 1}},
+		},
+	}
+	if !reflect.DeepEqual(player, want) {
+		t.Errorf("migrateCountsToInts = %v, want %v", player, want)
+	}
+
+	if err := migrateCountsToInts(map[string]interface{}{"played": "3"}); err == nil {
+		t.Errorf("migrateCountsToInts accepted a counter that is not a number")
+	}
+}
+
+func TestSetupDBMigratesBaselinePlayer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cliordle.db")
+	legacy, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = legacy.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucket([]byte("DB"))
+		if err != nil {
+			return err
+		}
+		return root.Put([]byte("PLAYER"), []byte(playerBaseline))
+	})
+	legacy.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = setupDB(path); err != nil {
+		t.Fatalf("setupDB: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err = useProfile(""); err != nil {
+		t.Fatal(err)
+	}
+	var envelope playerEnvelope
+	err = db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte("DB")).Get([]byte("PLAYER")) != nil {
+			return fmt.Errorf("player was left outside the default profile")
+		}
+		return json.Unmarshal(profileBucket(tx).Get([]byte("PLAYER")), &envelope)
+	})
+	if err != nil {
+		t.Fatalf("migrated player: %v", err)
+	}
+	if envelope.Version != playerVersion {
+		t.Errorf("migrated player is version %d, want %d", envelope.Version, playerVersion)
+	}
+
+	p, err := initPlayer()
+	if err != nil {
+		t.Fatalf("initPlayer: %v", err)
+	}
+	want := game.Player{
+		Stats:      game.Stats{Played: 3, Won: 2, CurrStreak: 1, LongestStreak: 2, Distribution: []int{0, 1, 1, 0, 0, 0}},
+		HiContrast: true,
+	}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("initPlayer = %+v, want %+v", p, want)
+	}
+}
+
+func TestUpgradePlayerCurrent(t *testing.T) {
+	p, err := decodePlayer([]byte(playerBaseline))
+	if err != nil {
+		t.Fatalf("decodePlayer: %v", err)
+	}
+	data, err := encodePlayer(&p)
+	if err != nil {
+		t.Fatalf("encodePlayer: %v", err)
+	}
+	var envelope playerEnvelope
+	if err = json.Unmarshal(data, &envelope); err != nil {
+		t.Fatalf("encoded player is not an envelope: %v", err)
+	}
+	if envelope.Version != playerVersion {
+		t.Errorf("encoded player is version %d, want %d", envelope.Version, playerVersion)
+	}
+
+	playerBytes, upgraded, err := upgradePlayer(data)
+	if err != nil {
+		t.Fatalf("upgradePlayer: %v", err)
+	}
+	if upgraded {
+		t.Errorf("upgradePlayer upgraded a current player")
+	}
+	if string(playerBytes) != string(envelope.Player) {
+		t.Errorf("upgradePlayer = %s, want %s", playerBytes, envelope.Player)
+	}
+	decoded, err := decodePlayer(data)
+	if err != nil {
+		t.Fatalf("decodePlayer: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, p) {
+		t.Errorf("decodePlayer = %+v, want %+v", decoded, p)
+	}
+}
+
+func TestUpgradePlayerNewer(t *testing.T) {
+	data := fmt.Sprintf(`{"version":%d,"player":{}}`, playerVersion+1)
+	_, _, err := upgradePlayer([]byte(data))
+	if err == nil || !strings.Contains(err.Error(), "newer than this version") {
+		t.Errorf("upgradePlayer(%s) = %v, want a newer version error", data, err)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/j985chen/cli-ordle/game"
+	"github.com/j985chen/cli-ordle/score"
+	"golang.org/x/term"
+)
+
+// without colour, statuses are shown by the brackets around a letter
+const markerCorrect = "[%s]"
+const markerPresent = "(%s)"
+
+// useColour is whether letters are coloured by their status, set by setColourMode
+var useColour = true
+
+// setColourMode sets whether to use colour from the --color option. auto uses
+// colour only when stdout is a terminal and the NO_COLOR env var is not set
+func setColourMode(mode string) error {
+	switch mode {
+	case "auto":
+		useColour = isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == ""
+	case "always":
+		useColour = true
+	case "never":
+		useColour = false
+	default:
+		return fmt.Errorf("--color should be auto, always, or never")
+	}
+	return nil
+}
+
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// tile renders a letter in the theme's colour for its status, padded to three
+// characters. Without colour, correct and present letters are marked instead
+func tile(letter string, status score.LetterStatus, t theme) string {
+	switch status {
+	case score.Correct:
+		if !useColour {
+			return fmt.Sprintf(markerCorrect, strings.ToUpper(letter))
+		}
+		return colour(t.Correct, letter)
+	case score.Present:
+		if !useColour {
+			return fmt.Sprintf(markerPresent, strings.ToUpper(letter))
+		}
+		return colour(t.Present, letter)
+	case score.Absent:
+		return colour(t.Absent, letter)
+	}
+	return colour(t.Empty, letter)
+}
+
+// printBoard prints every row of the board to w, with the letters typed so far
+// for the next guess in the first empty row
+func printBoard(w io.Writer, g *game.Game, t theme, pending string) {
+	length := g.Length()
+	fmt.Fprintln(w, strings.TrimRight(strings.Repeat(" ___ ", length), " "))
+	rowDivider := strings.TrimRight(strings.Repeat(" --- ", length), " ")
+	for i := 0; i < len(g.Guesses); i++ {
+		for j := 0; j < length; j++ {
+			letter := string(g.Guesses[i].Word[j])
+			fmt.Fprintf(w, "|%s|", tile(letter, g.Guesses[i].Statuses[j], t))
+		}
+		fmt.Fprintln(w, "\n"+rowDivider)
+	}
+	for i := len(g.Guesses); i < g.GuessLimit(); i++ {
+		for j := 0; j < length; j++ {
+			letter := " "
+			if i == len(g.Guesses) && j < len(pending) {
+				letter = string(pending[j])
+			}
+			fmt.Fprintf(w, "|%s|", tile(letter, score.Unknown, t))
+		}
+		fmt.Fprintln(w, "\n"+rowDivider)
+	}
+	fmt.Fprintln(w)
+}
+
+var keyboardRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// printKeyboard prints a QWERTY keyboard to w, with each letter coloured by the
+// best status it has been given in the guesses so far. Without colour, letters
+// that are not in the word are shown in lower case
+func printKeyboard(w io.Writer, g *game.Game, t theme) {
+	statuses := g.LetterStatuses()
+	for i, row := range keyboardRows {
+		fmt.Fprint(w, strings.Repeat(" ", i*2))
+		for j := 0; j < len(row); j++ {
+			letter := string(row[j])
+			status := statuses[row[j]]
+			if status != score.Absent || useColour {
+				letter = strings.ToUpper(letter)
+			}
+			if status == score.Unknown {
+				// unguessed keys are left plain rather than drawn as empty tiles
+				fmt.Fprintf(w, " %s ", letter)
+			} else {
+				fmt.Fprint(w, tile(letter, status, t))
+			}
+			if j < len(row)-1 {
+				fmt.Fprint(w, " ")
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,87 @@
+package score
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LetterStatus is the feedback given for a single letter of a guess
+type LetterStatus int
+
+// statuses are ordered from least to most revealing
+const (
+	Unknown LetterStatus = iota
+	Absent
+	Present
+	Correct
+)
+
+var statusNames = map[LetterStatus]string{
+	Unknown: "",
+	Absent:  "absent",
+	Present: "present",
+	Correct: "correct",
+}
+
+func (s LetterStatus) String() string {
+	name, ok := statusNames[s]
+	if !ok {
+		return fmt.Sprintf("LetterStatus(%d)", int(s))
+	}
+	return name
+}
+
+func (s LetterStatus) MarshalJSON() ([]byte, error) {
+	if _, ok := statusNames[s]; !ok {
+		return nil, fmt.Errorf("invalid letter status %d", int(s))
+	}
+	return json.Marshal(s.String())
+}
+
+func (s *LetterStatus) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("letter status should be a string: %v", err)
+	}
+	for status, statusName := range statusNames {
+		if name == statusName {
+			*s = status
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown letter status %q", name)
+}
+
+// Score returns the status of each letter of guess when compared against answer,
+// or nil if they are not the same length. Exact matches are marked first, and a
+// repeated letter is only marked present as many times as it appears unmatched
+// in the answer
+func Score(guess string, answer string) []LetterStatus {
+	if len(guess) != len(answer) {
+		return nil
+	}
+	statuses := make([]LetterStatus, len(guess))
+	solutionCharsUsed := make([]bool, len(answer))
+
+	for i := range statuses {
+		if guess[i] == answer[i] {
+			statuses[i] = Correct
+			solutionCharsUsed[i] = true
+		}
+	}
+
+	for i := range statuses {
+		if statuses[i] != Unknown {
+			continue
+		}
+		statuses[i] = Absent
+		for j := range solutionCharsUsed {
+			if answer[j] == guess[i] && !solutionCharsUsed[j] {
+				statuses[i] = Present
+				solutionCharsUsed[j] = true
+				break
+			}
+		}
+	}
+	return statuses
+}
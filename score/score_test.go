@@ -0,0 +1,30 @@
+package score
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		guess  string
+		answer string
+		want   []LetterStatus
+	}{
+		{"cigar", "cigar", []LetterStatus{Correct, Correct, Correct, Correct, Correct}},
+		{"crane", "cigar", []LetterStatus{Correct, Present, Present, Absent, Absent}},
+		// only one e is in the answer, so only the first e is present
+		{"speed", "abide", []LetterStatus{Absent, Absent, Present, Absent, Present}},
+		// exact matches are marked before present letters
+		{"geese", "those", []LetterStatus{Absent, Absent, Absent, Correct, Correct}},
+		{"eerie", "ether", []LetterStatus{Correct, Present, Present, Absent, Absent}},
+		{"cigars", "cigar", nil},
+		{"ciga", "cigar", nil},
+	}
+	for _, test := range tests {
+		got := Score(test.guess, test.answer)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Score(%q, %q) = %v, want %v", test.guess, test.answer, got, test.want)
+		}
+	}
+}
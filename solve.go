@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/j985chen/cli-ordle/solver"
+)
+
+// how many suggestions and candidates to list
+const numSuggestions = 5
+const maxListedCandidates = 10
+
+// solveGame runs the solve subcommand, which reads each guess and the pattern
+// it was given and suggests the next guess
+func solveGame(length int) error {
+	s, err := solver.New(length)
+	if err != nil {
+		return err
+	}
+	fmt.Println("---   CLIORDLE SOLVER   ---")
+	fmt.Println("Enter each guess followed by its pattern, with a character for each letter:")
+	fmt.Println("g if it is correct, y if it is present, or . if it is absent")
+	fmt.Println()
+	printSuggestions(s)
+	for len(s.Candidates) > 1 {
+		fmt.Print("Guess and pattern: ")
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			fmt.Println()
+			return nil
+		}
+		fields := strings.Fields(strings.ToLower(input))
+		if len(fields) == 0 {
+			return nil
+		} else if len(fields) != 2 {
+			fmt.Println("Enter a guess and its pattern separated by a space")
+			continue
+		}
+		statuses, err := solver.ParsePattern(fields[1], length)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if err = s.Apply(fields[0], statuses); err != nil {
+			fmt.Printf("%v, check the guess and pattern\n", err)
+			continue
+		}
+		if fields[1] == strings.Repeat("g", length) {
+			fmt.Println("Solved!")
+			return nil
+		}
+		printSuggestions(s)
+	}
+	return nil
+}
+
+func printSuggestions(s *solver.Solver) {
+	if len(s.Candidates) == 1 {
+		fmt.Printf("The answer is %s\n", s.Candidates[0])
+		return
+	}
+	fmt.Printf("%d possible answers remain\n", len(s.Candidates))
+	if len(s.Candidates) <= maxListedCandidates {
+		fmt.Println(strings.Join(s.Candidates, ", "))
+	}
+	fmt.Println("Best guesses:")
+	for _, suggestion := range s.Best(numSuggestions) {
+		fmt.Printf("  %s\t%.2f bits", suggestion.Word, suggestion.Entropy)
+		if suggestion.Candidate {
+			fmt.Print("\t(possible answer)")
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+}
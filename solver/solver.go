@@ -0,0 +1,237 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/j985chen/cli-ordle/score"
+	"github.com/j985chen/cli-ordle/words"
+)
+
+var ErrNoCandidates = errors.New("no answers match every pattern")
+
+// Solver tracks the candidate answers for a game as guesses are made
+type Solver struct {
+	Length     int
+	Candidates []string
+	guesses    []string // every valid guess
+}
+
+func New(length int) (*Solver, error) {
+	answers, err := words.Answers(length)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := words.Valid(length)
+	if err != nil {
+		return nil, err
+	}
+	return &Solver{Length: length, Candidates: answers, guesses: valid}, nil
+}
+
+// Apply removes the candidates that don't give the statuses for guess. If
+// none would remain, ErrNoCandidates is returned and the candidates are kept
+func (s *Solver) Apply(guess string, statuses []score.LetterStatus) error {
+	if len(guess) != s.Length || len(statuses) != s.Length {
+		return fmt.Errorf("guess and pattern must have %d letters", s.Length)
+	}
+	if !words.IsValidGuess(guess) {
+		return fmt.Errorf("%s is not a valid guess", guess)
+	}
+	remaining := Filter(s.Candidates, guess, statuses)
+	if len(remaining) == 0 {
+		return ErrNoCandidates
+	}
+	s.Candidates = remaining
+	return nil
+}
+
+// Best returns the n guesses that are expected to narrow down the candidates
+// the most
+func (s *Solver) Best(n int) []Suggestion {
+	if len(s.Candidates) <= 2 {
+		// guessing a candidate can win outright, which no other guess beats
+		return Rank(s.Candidates, s.Candidates, n)
+	}
+	return Rank(s.guesses, s.Candidates, n)
+}
+
+// Filter returns the candidates that would give the statuses for guess if
+// they were the answer
+func Filter(candidates []string, guess string, statuses []score.LetterStatus) []string {
+	want := patternCode(statuses)
+	var remaining []string
+	for _, candidate := range candidates {
+		if len(candidate) == len(guess) && scoreCode(guess, candidate) == want {
+			remaining = append(remaining, candidate)
+		}
+	}
+	return remaining
+}
+
+// Entropy returns the expected information in bits given by guess, that is
+// how much it is expected to halve the candidates
+func Entropy(guess string, candidates []string) float64 {
+	return newCounter(len(guess)).entropy(guess, candidates)
+}
+
+// Suggestion is a ranked guess
+type Suggestion struct {
+	Word      string
+	Entropy   float64
+	Candidate bool // whether the guess could be the answer
+}
+
+// Rank returns the n guesses with the highest entropy over candidates. Ties
+// are broken in favour of guesses that could be the answer
+func Rank(guesses []string, candidates []string, n int) []Suggestion {
+	if len(candidates) == 0 {
+		return nil
+	}
+	isCandidate := map[string]bool{}
+	for _, candidate := range candidates {
+		isCandidate[candidate] = true
+	}
+	suggestions := make([]Suggestion, len(guesses))
+	workers := runtime.NumCPU()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			c := newCounter(len(candidates[0]))
+			for i := w; i < len(guesses); i += workers {
+				suggestions[i] = Suggestion{guesses[i], c.entropy(guesses[i], candidates), isCandidate[guesses[i]]}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if math.Abs(a.Entropy-b.Entropy) > 1e-9 {
+			return a.Entropy > b.Entropy
+		} else if a.Candidate != b.Candidate {
+			return a.Candidate
+		}
+		return a.Word < b.Word
+	})
+	if n > 0 && n < len(suggestions) {
+		suggestions = suggestions[:n]
+	}
+	return suggestions
+}
+
+// ParsePattern reads the statuses of a guess written with a character per
+// letter: g for correct, y for present, and . or x for absent
+func ParsePattern(pattern string, length int) ([]score.LetterStatus, error) {
+	if len(pattern) != length {
+		return nil, fmt.Errorf("pattern must have %d letters", length)
+	}
+	statuses := make([]score.LetterStatus, length)
+	for i, c := range strings.ToLower(pattern) {
+		switch c {
+		case 'g':
+			statuses[i] = score.Correct
+		case 'y':
+			statuses[i] = score.Present
+		case '.', 'x':
+			statuses[i] = score.Absent
+		default:
+			return nil, fmt.Errorf("pattern should only use g, y, and . or x, not %q", c)
+		}
+	}
+	return statuses, nil
+}
+
+// FormatPattern writes statuses in the form read by ParsePattern
+func FormatPattern(statuses []score.LetterStatus) string {
+	var sb strings.Builder
+	for _, status := range statuses {
+		switch status {
+		case score.Correct:
+			sb.WriteByte('g')
+		case score.Present:
+			sb.WriteByte('y')
+		default:
+			sb.WriteByte('.')
+		}
+	}
+	return sb.String()
+}
+
+// patterns are compared as base 3 numbers with a digit per letter, which is
+// much faster than comparing status slices when ranking every valid guess
+func patternCode(statuses []score.LetterStatus) int {
+	code := 0
+	for _, status := range statuses {
+		code *= 3
+		switch status {
+		case score.Correct:
+			code += 2
+		case score.Present:
+			code++
+		}
+	}
+	return code
+}
+
+// scoreCode is score.Score followed by patternCode, without allocating
+func scoreCode(guess string, answer string) int {
+	var statuses [words.MaxLength]score.LetterStatus
+	var used [words.MaxLength]bool
+	for i := 0; i < len(guess); i++ {
+		if guess[i] == answer[i] {
+			statuses[i] = score.Correct
+			used[i] = true
+		}
+	}
+	for i := 0; i < len(guess); i++ {
+		if statuses[i] == score.Correct {
+			continue
+		}
+		for j := 0; j < len(answer); j++ {
+			if answer[j] == guess[i] && !used[j] {
+				statuses[i] = score.Present
+				used[j] = true
+				break
+			}
+		}
+	}
+	return patternCode(statuses[:len(guess)])
+}
+
+// counter counts how many candidates give each pattern, reusing its buckets
+// between guesses
+type counter struct {
+	counts  []int
+	touched []int
+}
+
+func newCounter(length int) *counter {
+	return &counter{counts: make([]int, int(math.Pow(3, float64(length))))}
+}
+
+func (c *counter) entropy(guess string, candidates []string) float64 {
+	c.touched = c.touched[:0]
+	for _, candidate := range candidates {
+		code := scoreCode(guess, candidate)
+		if c.counts[code] == 0 {
+			c.touched = append(c.touched, code)
+		}
+		c.counts[code]++
+	}
+	total := float64(len(candidates))
+	entropy := 0.0
+	for _, code := range c.touched {
+		p := float64(c.counts[code]) / total
+		entropy -= p * math.Log2(p)
+		c.counts[code] = 0
+	}
+	return entropy
+}
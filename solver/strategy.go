@@ -0,0 +1,45 @@
+package solver
+
+import "math/rand"
+
+// Strategy chooses the next guess from the candidates left in a solver
+type Strategy struct {
+	Name        string
+	Description string
+	Random      bool // whether the same candidates can give different guesses
+	Choose      func(s *Solver, r *rand.Rand) string
+}
+
+var Strategies = []Strategy{
+	{
+		Name:        "entropy",
+		Description: "the valid guess with the most expected information",
+		Choose: func(s *Solver, r *rand.Rand) string {
+			return s.Best(1)[0].Word
+		},
+	},
+	{
+		Name:        "candidates",
+		Description: "the possible answer with the most expected information",
+		Choose: func(s *Solver, r *rand.Rand) string {
+			return Rank(s.Candidates, s.Candidates, 1)[0].Word
+		},
+	},
+	{
+		Name:        "random",
+		Description: "a random possible answer",
+		Random:      true,
+		Choose: func(s *Solver, r *rand.Rand) string {
+			return s.Candidates[r.Intn(len(s.Candidates))]
+		},
+	},
+}
+
+func FindStrategy(name string) (Strategy, bool) {
+	for _, strategy := range Strategies {
+		if strategy.Name == name {
+			return strategy, true
+		}
+	}
+	return Strategy{}, false
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// rebuildStats recomputes stats from the game history, reporting every counter
+// that disagrees with the stored stats. The stored stats are only replaced if
+// fix is set, and never when they count more games than the history holds,
+// since games finished before the history was recorded would be lost
+func rebuildStats(p *game.Player, fix bool) error {
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	rebuilt := game.Player{}
+	for _, entry := range entries {
+		rebuilt.RecordGame(entry.Game)
+	}
+
+	limits := []int{game.DefaultMaxGuesses}
+	for limit := range p.Variants {
+		limits = append(limits, limit)
+	}
+	for limit := range rebuilt.Variants {
+		if p.Variants[limit] == nil {
+			limits = append(limits, limit)
+		}
+	}
+	sort.Ints(limits)
+
+	fmt.Println("---    REBUILDING STATS    ---")
+	fmt.Printf("Recomputed stats from %d recorded games\n", len(entries))
+	mismatches := 0
+	unrecorded := false
+	for _, limit := range limits {
+		stored := p.StatsFor(limit)
+		recomputed := rebuilt.StatsFor(limit)
+		for _, diff := range diffStats(stored, recomputed) {
+			fmt.Printf("%d guesses | %s\n", limit, diff)
+			mismatches++
+		}
+		if stored.Played > recomputed.Played {
+			unrecorded = true
+		}
+	}
+	if mismatches == 0 {
+		fmt.Println("Stored stats match the history")
+		return nil
+	}
+	fmt.Printf("%d stats do not match the history\n", mismatches)
+	if unrecorded {
+		fmt.Println("The stored stats count games missing from the history, which may have been")
+		fmt.Println("played before the history was recorded, so they have been kept")
+		return nil
+	}
+	if !fix {
+		fmt.Println("Run stats --rebuild --fix to replace the stored stats with the recomputed ones")
+		return nil
+	}
+
+	p.Stats = rebuilt.Stats
+	p.Variants = rebuilt.Variants
+	fmt.Println("Replaced the stored stats with the recomputed ones")
+	return savePlayer(p)
+}
+
+// diffStats describes each counter that differs between stored & recomputed stats
+func diffStats(stored *game.Stats, recomputed *game.Stats) []string {
+	var diffs []string
+	compare := func(name string, storedVal int, recomputedVal int) {
+		if storedVal != recomputedVal {
+			diffs = append(diffs, fmt.Sprintf("%s: stored %d, history %d", name, storedVal, recomputedVal))
+		}
+	}
+	compare("Played", stored.Played, recomputed.Played)
+	compare("Won", stored.Won, recomputed.Won)
+	compare("Won with hints", stored.HintedWins, recomputed.HintedWins)
+	compare("Current streak", stored.CurrStreak, recomputed.CurrStreak)
+	compare("Longest streak", stored.LongestStreak, recomputed.LongestStreak)
+	for i := 0; i < len(stored.Distribution) || i < len(recomputed.Distribution); i++ {
+		var storedVal, recomputedVal int
+		if i < len(stored.Distribution) {
+			storedVal = stored.Distribution[i]
+		}
+		if i < len(recomputed.Distribution) {
+			recomputedVal = recomputed.Distribution[i]
+		}
+		compare(fmt.Sprintf("Won in %d", i+1), storedVal, recomputedVal)
+	}
+	return diffs
+}
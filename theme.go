@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/j985chen/cli-ordle/game"
+)
+
+// theme holds the ANSI SGR codes used to colour each kind of tile, like "42"
+// for a green background, "48;5;202" for a 256-colour one or "48;2;106;170;100"
+// for truecolor. A tile with no code is left plain
+type theme struct {
+	Correct string `json:"correct"`
+	Present string `json:"present"`
+	Absent  string `json:"absent"`
+	Empty   string `json:"empty"`
+}
+
+const defaultTheme = "classic"
+
+var builtinThemes = map[string]theme{
+	"classic":       {Correct: "42", Present: "43", Absent: "90"},
+	"high-contrast": {Correct: "48;5;202", Present: "46", Absent: "90"},
+	"monochrome":    {Correct: "7", Present: "4", Absent: "2"},
+	"dark":          {Correct: "97;48;5;28", Present: "97;48;5;136", Absent: "37;48;5;239", Empty: "48;5;236"},
+}
+
+func (t theme) validate() error {
+	for _, code := range []string{t.Correct, t.Present, t.Absent, t.Empty} {
+		if strings.Trim(code, "0123456789;") != "" {
+			return fmt.Errorf("%q is not an SGR code like 42 or 48;5;202", code)
+		}
+	}
+	return nil
+}
+
+// colour renders text padded to a tile with an SGR code
+func colour(code string, text string) string {
+	if code == "" || !useColour {
+		return " " + text + " "
+	}
+	return "\033[" + code + "m " + text + " \033[0m"
+}
+
+// findTheme looks up a theme from the config file or the built-in ones
+func findTheme(name string) (theme, bool) {
+	if t, ok := userConfig.Themes[name]; ok {
+		return t, true
+	}
+	t, ok := builtinThemes[name]
+	return t, ok
+}
+
+func themeNames() []string {
+	var names []string
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	for name := range userConfig.Themes {
+		if _, ok := builtinThemes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// playerThemeName returns the theme from the config, or else the player's
+// chosen theme, or the one matching their high-contrast setting
+func playerThemeName(p *game.Player) string {
+	if userConfig.Theme != "" {
+		return userConfig.Theme
+	} else if p.Theme != "" {
+		return p.Theme
+	} else if p.HiContrast {
+		return "high-contrast"
+	}
+	return defaultTheme
+}
+
+// shareHiContrast is whether share grids use the high-contrast squares, which
+// follows the player's theme
+func shareHiContrast(p *game.Player) bool {
+	return playerThemeName(p) == "high-contrast"
+}
+
+// playerTheme returns the player's theme, falling back to the default one if
+// it has been removed from the config file
+func playerTheme(p *game.Player) theme {
+	name := playerThemeName(p)
+	t, ok := findTheme(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "theme %q not found, using %s\n", name, defaultTheme)
+		t = builtinThemes[defaultTheme]
+	}
+	return t
+}
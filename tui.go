@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/j985chen/cli-ordle/game"
+	"golang.org/x/term"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+// key codes read in raw mode
+const (
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyBackspace = 8
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+// errInterrupted is returned when the terminal UI is interrupted. The game is
+// saved after every guess, so it can be resumed
+var errInterrupted = errors.New("interrupted, run play again to resume the game")
+
+// checkTerminal returns an error if the terminal UI can't be used, so that it
+// can be checked before a game is started
+func checkTerminal() error {
+	if !isTerminal(os.Stdin) {
+		return fmt.Errorf("--tui requires an interactive terminal")
+	}
+	return nil
+}
+
+// enableRawMode stops the terminal from echoing input, buffering it by line
+// and handling ctrl-c itself, returning a function that restores the previous mode
+func enableRawMode() (func(), error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("--tui requires an interactive terminal")
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("could not set terminal mode: %v", err)
+	}
+	return func() {
+		term.Restore(fd, state)
+	}, nil
+}
+
+// readKeys sends each key read from stdin until reading fails. Arrow and
+// function keys send escape sequences that arrive in a single read, so the rest
+// of a read is skipped after an escape
+func readKeys(keys chan<- byte, errs chan<- error) {
+	buf := make([]byte, 32)
+	for {
+		n, err := reader.Read(buf)
+		if err != nil {
+			errs <- err
+			return
+		}
+		for _, key := range buf[:n] {
+			keys <- key
+			if key == keyEscape {
+				break
+			}
+		}
+	}
+}
+
+// playGameTUI plays a game in a screen that is redrawn in place after every
+// key press, with a keyboard showing the letters guessed so far
+func playGameTUI(p *game.Player, g *game.Game) error {
+	restore, err := enableRawMode()
+	if err != nil {
+		return err
+	}
+	defer restore()
+	// raw mode reads ctrl-c as a key, but an interrupt can still be sent by
+	// another process. Either one returns errInterrupted, so that the terminal
+	// is restored and the db closed on the way out
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	// the key reader is left blocked once the game ends, since nothing reads
+	// stdin after a game
+	keys := make(chan byte)
+	keyErrs := make(chan error, 1)
+	go readKeys(keys, keyErrs)
+
+	t := playerTheme(p)
+	var pending, message string
+	for g.State() == game.Playing {
+		drawTUI(p, g, t, pending, message)
+		var key byte
+		select {
+		case key = <-keys:
+		case err := <-keyErrs:
+			return fmt.Errorf("could not read key: %v", err)
+		case <-interrupts:
+			return errInterrupted
+		}
+		message = ""
+		switch {
+		case key >= 'a' && key <= 'z' || key >= 'A' && key <= 'Z':
+			if isCommand(pending) || len(pending) < g.Length() {
+				pending += strings.ToLower(string(key))
+			}
+		case key == ':' && pending == "", key == ' ' && isCommand(pending):
+			pending += string(key)
+		case key == keyBackspace || key == keyDelete:
+			if len(pending) > 0 {
+				pending = pending[:len(pending)-1]
+			}
+		case (key == '\n' || key == '\r') && isCommand(pending):
+			message, err = runCommand(g, pending)
+			if err != nil {
+				message = err.Error()
+			} else if err = saveGame(g); err != nil {
+				return err
+			}
+			pending = ""
+		case key == '\n' || key == '\r':
+			if len(pending) < g.Length() {
+				message = "Not enough letters"
+				break
+			}
+			_, err = g.Guess(pending)
+			if err == game.ErrInvalidGuess {
+				message = fmt.Sprintf("%s is not in the word list", strings.ToUpper(pending))
+			} else if err != nil {
+				message = fmt.Sprintf("Not allowed in hard mode: %v", err)
+			} else {
+				pending = ""
+				if err = saveGame(g); err != nil {
+					return err
+				}
+			}
+		case key == keyCtrlC:
+			return errInterrupted
+		case key == keyCtrlD:
+			drawTUI(p, g, t, pending, "Game saved, run play again to resume it")
+			return nil
+		}
+	}
+	restore()
+	drawTUI(p, g, t, "", "")
+	return finishGame(p, g)
+}
+
+func drawTUI(p *game.Player, g *game.Game, t theme, pending string, message string) {
+	if isCommand(pending) {
+		// commands are typed below the board rather than into it
+		message = pending
+		pending = ""
+	}
+	var screen strings.Builder
+	screen.WriteString(clearScreen)
+	printHeader(&screen, g)
+	printBoard(&screen, g, t, pending)
+	printKeyboard(&screen, g, t)
+	if p.ShowCandidates && g.State() == game.Playing {
+		fmt.Fprintln(&screen, candidatesRemaining(len(g.Candidates())))
+	}
+	if message != "" {
+		fmt.Fprintln(&screen, message)
+	} else if g.State() == game.Playing {
+		fmt.Fprintln(&screen, "Type a guess and press enter, :hint for a hint, ctrl-d to stop")
+	}
+	// raw mode doesn't return the cursor to the start of the line on a newline
+	fmt.Print(strings.ReplaceAll(screen.String(), "\n", "\r\n"))
+}
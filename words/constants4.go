@@ -0,0 +1,2202 @@
+package words
+
+var words4 = []string{
+	"ship",
+	"cold",
+	"bomb",
+	"seen",
+	"logo",
+	"hand",
+	"move",
+	"lord",
+	"cool",
+	"tide",
+	"only",
+	"hung",
+	"near",
+	"sole",
+	"baby",
+	"load",
+	"rent",
+	"head",
+	"here",
+	"mind",
+	"tend",
+	"fast",
+	"ring",
+	"okay",
+	"cope",
+	"onto",
+	"army",
+	"they",
+	"form",
+	"hope",
+	"spot",
+	"burn",
+	"holy",
+	"live",
+	"seed",
+	"kept",
+	"weak",
+	"gain",
+	"boss",
+	"star",
+	"mark",
+	"park",
+	"real",
+	"twin",
+	"wait",
+	"shut",
+	"wake",
+	"long",
+	"stop",
+	"skin",
+	"laid",
+	"foot",
+	"mood",
+	"club",
+	"else",
+	"jump",
+	"yard",
+	"neck",
+	"suit",
+	"held",
+	"help",
+	"well",
+	"dawn",
+	"bear",
+	"rich",
+	"core",
+	"task",
+	"rate",
+	"base",
+	"roof",
+	"text",
+	"dark",
+	"does",
+	"wide",
+	"ride",
+	"rank",
+	"mine",
+	"door",
+	"user",
+	"down",
+	"palm",
+	"sale",
+	"mail",
+	"sell",
+	"vast",
+	"deal",
+	"boat",
+	"none",
+	"bulk",
+	"rear",
+	"grow",
+	"golf",
+	"like",
+	"time",
+	"home",
+	"pack",
+	"mill",
+	"goal",
+	"tale",
+	"rest",
+	"hill",
+	"role",
+	"lost",
+	"once",
+	"till",
+	"lane",
+	"king",
+	"yeah",
+	"view",
+	"farm",
+	"cost",
+	"root",
+	"room",
+	"need",
+	"stay",
+	"menu",
+	"test",
+	"when",
+	"soil",
+	"trip",
+	"rush",
+	"iron",
+	"sick",
+	"song",
+	"dial",
+	"thus",
+	"seat",
+	"wore",
+	"soon",
+	"tune",
+	"dear",
+	"even",
+	"pink",
+	"take",
+	"rice",
+	"jack",
+	"snow",
+	"talk",
+	"hunt",
+	"very",
+	"such",
+	"huge",
+	"camp",
+	"acid",
+	"four",
+	"crop",
+	"poor",
+	"true",
+	"dish",
+	"open",
+	"town",
+	"peak",
+	"duke",
+	"wash",
+	"show",
+	"post",
+	"exit",
+	"felt",
+	"deep",
+	"chat",
+	"male",
+	"beer",
+	"will",
+	"nine",
+	"fail",
+	"five",
+	"drug",
+	"past",
+	"look",
+	"hero",
+	"blue",
+	"pool",
+	"nose",
+	"wife",
+	"lift",
+	"work",
+	"your",
+	"knew",
+	"fate",
+	"warm",
+	"seek",
+	"belt",
+	"what",
+	"rope",
+	"aged",
+	"poem",
+	"less",
+	"drop",
+	"wood",
+	"bowl",
+	"sort",
+	"moon",
+	"with",
+	"film",
+	"ways",
+	"give",
+	"hell",
+	"back",
+	"much",
+	"away",
+	"paid",
+	"went",
+	"tour",
+	"milk",
+	"pile",
+	"tall",
+	"wild",
+	"zero",
+	"make",
+	"team",
+	"sign",
+	"seem",
+	"from",
+	"code",
+	"duty",
+	"meet",
+	"inch",
+	"keep",
+	"plot",
+	"bone",
+	"crew",
+	"term",
+	"toll",
+	"cake",
+	"wise",
+	"able",
+	"jury",
+	"coat",
+	"wear",
+	"pass",
+	"hour",
+	"zone",
+	"game",
+	"wish",
+	"grey",
+	"heat",
+	"save",
+	"last",
+	"beat",
+	"meat",
+	"hard",
+	"data",
+	"idea",
+	"born",
+	"wall",
+	"line",
+	"soft",
+	"bank",
+	"cast",
+	"harm",
+	"gray",
+	"made",
+	"days",
+	"main",
+	"rain",
+	"bush",
+	"meal",
+	"item",
+	"have",
+	"fort",
+	"oral",
+	"page",
+	"navy",
+	"sure",
+	"girl",
+	"upon",
+	"want",
+	"evil",
+	"that",
+	"cash",
+	"type",
+	"mass",
+	"wage",
+	"keen",
+	"cook",
+	"grew",
+	"body",
+	"half",
+	"dead",
+	"wave",
+	"knee",
+	"feed",
+	"slip",
+	"more",
+	"fill",
+	"firm",
+	"fish",
+	"pain",
+	"been",
+	"dust",
+	"mean",
+	"fact",
+	"lake",
+	"play",
+	"rock",
+	"know",
+	"fair",
+	"pipe",
+	"plus",
+	"rose",
+	"race",
+	"file",
+	"soul",
+	"love",
+	"easy",
+	"kick",
+	"same",
+	"most",
+	"sent",
+	"bell",
+	"safe",
+	"turn",
+	"shot",
+	"many",
+	"news",
+	"best",
+	"used",
+	"debt",
+	"path",
+	"rule",
+	"bond",
+	"side",
+	"wind",
+	"come",
+	"push",
+	"lady",
+	"ever",
+	"lead",
+	"cell",
+	"over",
+	"year",
+	"hold",
+	"rely",
+	"done",
+	"list",
+	"tech",
+	"hole",
+	"area",
+	"tiny",
+	"send",
+	"diet",
+	"then",
+	"hurt",
+	"fire",
+	"date",
+	"took",
+	"life",
+	"each",
+	"pole",
+	"gift",
+	"busy",
+	"roll",
+	"poll",
+	"kill",
+	"read",
+	"deny",
+	"slow",
+	"them",
+	"site",
+	"ball",
+	"hall",
+	"road",
+	"gate",
+	"came",
+	"said",
+	"sold",
+	"bill",
+	"week",
+	"loan",
+	"walk",
+	"draw",
+	"hang",
+	"copy",
+	"fell",
+	"poet",
+	"pair",
+	"full",
+	"fear",
+	"sand",
+	"boom",
+	"chip",
+	"hair",
+	"east",
+	"note",
+	"pull",
+	"gulf",
+	"part",
+	"size",
+	"late",
+	"high",
+	"dual",
+	"whom",
+	"left",
+	"pour",
+	"pure",
+	"edge",
+	"west",
+	"word",
+	"ease",
+	"risk",
+	"dean",
+	"hear",
+	"sake",
+	"lose",
+	"rare",
+	"must",
+	"miss",
+	"vote",
+	"port",
+	"lock",
+	"blow",
+	"calm",
+	"bird",
+	"tank",
+	"flat",
+	"gold",
+	"feel",
+	"dirt",
+	"link",
+	"land",
+	"gave",
+	"pick",
+	"lack",
+	"kind",
+	"ward",
+	"mile",
+	"wine",
+	"good",
+	"salt",
+	"pace",
+	"gene",
+	"also",
+	"glad",
+	"told",
+	"next",
+	"than",
+	"care",
+	"fund",
+	"loss",
+	"find",
+	"drew",
+	"fine",
+	"mere",
+	"into",
+	"rise",
+	"hate",
+	"plan",
+	"tone",
+	"card",
+	"host",
+	"vice",
+	"tool",
+	"tree",
+	"shop",
+	"unit",
+	"pose",
+	"wire",
+	"gone",
+	"disk",
+	"earn",
+	"free",
+	"wing",
+	"flow",
+	"tell",
+	"rail",
+	"this",
+	"bath",
+	"face",
+	"food",
+	"coal",
+	"thin",
+	"dose",
+	"step",
+	"fuel",
+	"hire",
+	"name",
+	"mode",
+	"fall",
+	"book",
+	"some",
+	"tape",
+	"gear",
+	"were",
+	"nice",
+	"just",
+	"band",
+	"both",
+	"case",
+	"desk",
+	"luck",
+	"join",
+	"self",
+	"city",
+	"goes",
+	"feet",
+}
+
+var valid4 = []string{
+	"abed",
+	"abet",
+	"able",
+	"ably",
+	"abut",
+	"aces",
+	"ache",
+	"achy",
+	"acid",
+	"acme",
+	"acne",
+	"acre",
+	"acts",
+	"adds",
+	"aeon",
+	"afar",
+	"aged",
+	"agog",
+	"ahoy",
+	"aide",
+	"aids",
+	"ails",
+	"aims",
+	"airs",
+	"airy",
+	"ajar",
+	"akin",
+	"alas",
+	"albs",
+	"ales",
+	"alms",
+	"aloe",
+	"alps",
+	"also",
+	"alto",
+	"alum",
+	"amen",
+	"amid",
+	"amok",
+	"amps",
+	"anew",
+	"ankh",
+	"ante",
+	"anti",
+	"ants",
+	"apes",
+	"apex",
+	"aqua",
+	"arch",
+	"arcs",
+	"area",
+	"ares",
+	"aria",
+	"arid",
+	"arks",
+	"arms",
+	"army",
+	"arty",
+	"ashy",
+	"atom",
+	"atop",
+	"aunt",
+	"aura",
+	"auto",
+	"avid",
+	"avow",
+	"away",
+	"awed",
+	"awes",
+	"awry",
+	"axes",
+	"axis",
+	"axle",
+	"babe",
+	"baby",
+	"back",
+	"bade",
+	"bags",
+	"bail",
+	"bait",
+	"bake",
+	"bald",
+	"bale",
+	"balk",
+	"ball",
+	"balm",
+	"band",
+	"bane",
+	"bang",
+	"bank",
+	"bans",
+	"barb",
+	"bard",
+	"bare",
+	"bark",
+	"barn",
+	"bars",
+	"base",
+	"bash",
+	"bass",
+	"bath",
+	"bats",
+	"bawl",
+	"bays",
+	"bead",
+	"beak",
+	"beam",
+	"bean",
+	"bear",
+	"beat",
+	"beds",
+	"beef",
+	"been",
+	"beep",
+	"beer",
+	"bees",
+	"begs",
+	"bell",
+	"belt",
+	"bend",
+	"bent",
+	"berg",
+	"berm",
+	"best",
+	"bias",
+	"bibs",
+	"bide",
+	"bike",
+	"bile",
+	"bilk",
+	"bill",
+	"bind",
+	"bins",
+	"bird",
+	"bite",
+	"bits",
+	"blab",
+	"blip",
+	"blob",
+	"bloc",
+	"blog",
+	"blot",
+	"blow",
+	"blue",
+	"blur",
+	"boar",
+	"boat",
+	"bode",
+	"body",
+	"bogs",
+	"boil",
+	"bold",
+	"bolt",
+	"bomb",
+	"bond",
+	"bone",
+	"bony",
+	"book",
+	"boom",
+	"boon",
+	"boor",
+	"boot",
+	"bore",
+	"born",
+	"boss",
+	"both",
+	"bout",
+	"bowl",
+	"bows",
+	"brag",
+	"bran",
+	"bras",
+	"brat",
+	"brew",
+	"brie",
+	"brim",
+	"brow",
+	"buck",
+	"buds",
+	"buff",
+	"bugs",
+	"bulb",
+	"bulk",
+	"bull",
+	"bump",
+	"bums",
+	"bunk",
+	"buns",
+	"buoy",
+	"burn",
+	"burp",
+	"burr",
+	"bury",
+	"bush",
+	"bust",
+	"busy",
+	"buys",
+	"buzz",
+	"byte",
+	"cabs",
+	"cafe",
+	"cage",
+	"cake",
+	"calm",
+	"came",
+	"camp",
+	"cane",
+	"cans",
+	"cape",
+	"caps",
+	"carb",
+	"card",
+	"care",
+	"carp",
+	"cars",
+	"cart",
+	"case",
+	"cash",
+	"cast",
+	"cave",
+	"cede",
+	"cell",
+	"cent",
+	"chap",
+	"chat",
+	"chef",
+	"chew",
+	"chin",
+	"chip",
+	"chop",
+	"chow",
+	"cite",
+	"city",
+	"clad",
+	"clam",
+	"clan",
+	"clap",
+	"claw",
+	"clay",
+	"clip",
+	"clog",
+	"clot",
+	"club",
+	"clue",
+	"coal",
+	"coat",
+	"coax",
+	"cobs",
+	"code",
+	"coil",
+	"coin",
+	"coke",
+	"cola",
+	"cold",
+	"colt",
+	"coma",
+	"comb",
+	"come",
+	"cone",
+	"cook",
+	"cool",
+	"cope",
+	"copy",
+	"cord",
+	"core",
+	"cork",
+	"corn",
+	"cost",
+	"cosy",
+	"cots",
+	"coup",
+	"cove",
+	"cowl",
+	"cows",
+	"cozy",
+	"crab",
+	"crew",
+	"crib",
+	"crop",
+	"crow",
+	"crux",
+	"cube",
+	"cubs",
+	"cuff",
+	"cull",
+	"cult",
+	"curb",
+	"curd",
+	"cure",
+	"curl",
+	"cusp",
+	"cute",
+	"cuts",
+	"cyst",
+	"czar",
+	"dabs",
+	"dads",
+	"daft",
+	"dais",
+	"dame",
+	"damp",
+	"dams",
+	"dank",
+	"dare",
+	"dark",
+	"darn",
+	"dart",
+	"dash",
+	"data",
+	"date",
+	"daub",
+	"dawn",
+	"days",
+	"daze",
+	"dead",
+	"deaf",
+	"deal",
+	"dean",
+	"dear",
+	"debt",
+	"deck",
+	"deed",
+	"deem",
+	"deep",
+	"deer",
+	"deft",
+	"defy",
+	"dell",
+	"dent",
+	"deny",
+	"desk",
+	"dews",
+	"dial",
+	"dice",
+	"died",
+	"dies",
+	"diet",
+	"dike",
+	"dill",
+	"dime",
+	"dine",
+	"ding",
+	"dint",
+	"dips",
+	"dire",
+	"dirt",
+	"disc",
+	"dish",
+	"disk",
+	"dive",
+	"dock",
+	"dodo",
+	"doer",
+	"does",
+	"doff",
+	"dogs",
+	"dole",
+	"doll",
+	"dome",
+	"done",
+	"dons",
+	"doom",
+	"door",
+	"dope",
+	"dork",
+	"dorm",
+	"dose",
+	"dote",
+	"dots",
+	"dour",
+	"dove",
+	"down",
+	"doze",
+	"dozy",
+	"drab",
+	"drag",
+	"dram",
+	"drat",
+	"draw",
+	"drew",
+	"drip",
+	"drop",
+	"drug",
+	"drum",
+	"dual",
+	"dubs",
+	"duck",
+	"duct",
+	"dude",
+	"duel",
+	"dues",
+	"duet",
+	"duff",
+	"duke",
+	"dune",
+	"dung",
+	"dunk",
+	"dupe",
+	"dusk",
+	"dust",
+	"duty",
+	"each",
+	"earl",
+	"earn",
+	"ears",
+	"ease",
+	"east",
+	"easy",
+	"eats",
+	"ebbs",
+	"echo",
+	"eddy",
+	"edge",
+	"edgy",
+	"edit",
+	"eels",
+	"eggs",
+	"egos",
+	"elks",
+	"elms",
+	"else",
+	"emit",
+	"ends",
+	"envy",
+	"epic",
+	"eras",
+	"errs",
+	"etch",
+	"euro",
+	"even",
+	"ever",
+	"eves",
+	"evil",
+	"exam",
+	"exit",
+	"expo",
+	"eyed",
+	"eyes",
+	"face",
+	"fact",
+	"fade",
+	"fads",
+	"fail",
+	"fair",
+	"fall",
+	"fame",
+	"fang",
+	"fare",
+	"farm",
+	"fast",
+	"fate",
+	"fawn",
+	"faze",
+	"fear",
+	"feat",
+	"feds",
+	"feed",
+	"feel",
+	"fees",
+	"feet",
+	"fell",
+	"felt",
+	"fend",
+	"fern",
+	"feta",
+	"feud",
+	"fibs",
+	"figs",
+	"file",
+	"fill",
+	"film",
+	"find",
+	"fine",
+	"fins",
+	"fire",
+	"firm",
+	"fish",
+	"fist",
+	"fits",
+	"five",
+	"fizz",
+	"flag",
+	"flak",
+	"flan",
+	"flap",
+	"flat",
+	"flaw",
+	"flax",
+	"flay",
+	"flea",
+	"fled",
+	"flex",
+	"flip",
+	"flit",
+	"floe",
+	"flog",
+	"flop",
+	"flow",
+	"flue",
+	"flux",
+	"foal",
+	"foam",
+	"fobs",
+	"foes",
+	"fogs",
+	"foil",
+	"fold",
+	"folk",
+	"fond",
+	"font",
+	"food",
+	"fool",
+	"foot",
+	"fore",
+	"fork",
+	"form",
+	"fort",
+	"foul",
+	"four",
+	"fowl",
+	"foxy",
+	"fray",
+	"free",
+	"fret",
+	"frog",
+	"from",
+	"fuel",
+	"full",
+	"fume",
+	"fund",
+	"furl",
+	"fury",
+	"fuse",
+	"fuss",
+	"fuzz",
+	"gaff",
+	"gags",
+	"gain",
+	"gala",
+	"gale",
+	"gall",
+	"gals",
+	"game",
+	"gape",
+	"gash",
+	"gasp",
+	"gate",
+	"gave",
+	"gawk",
+	"gaze",
+	"gear",
+	"geek",
+	"gems",
+	"gene",
+	"gent",
+	"germ",
+	"gets",
+	"gift",
+	"gild",
+	"gill",
+	"gilt",
+	"girl",
+	"gist",
+	"give",
+	"glad",
+	"glee",
+	"glen",
+	"glib",
+	"glow",
+	"glue",
+	"glum",
+	"glut",
+	"gnat",
+	"gnaw",
+	"goad",
+	"goal",
+	"goat",
+	"gods",
+	"goes",
+	"gold",
+	"golf",
+	"gone",
+	"good",
+	"goof",
+	"goon",
+	"gore",
+	"gory",
+	"gosh",
+	"gout",
+	"gown",
+	"grab",
+	"gram",
+	"gray",
+	"grew",
+	"grey",
+	"grid",
+	"grim",
+	"grin",
+	"grip",
+	"grit",
+	"grow",
+	"grub",
+	"gulf",
+	"gull",
+	"gulp",
+	"gums",
+	"guru",
+	"gush",
+	"gust",
+	"guts",
+	"guys",
+	"gyms",
+	"hack",
+	"hail",
+	"hair",
+	"hale",
+	"half",
+	"hall",
+	"halo",
+	"halt",
+	"hams",
+	"hand",
+	"hang",
+	"hard",
+	"hare",
+	"hark",
+	"harm",
+	"harp",
+	"hash",
+	"hasp",
+	"hate",
+	"haul",
+	"have",
+	"hawk",
+	"haze",
+	"hazy",
+	"head",
+	"heal",
+	"heap",
+	"hear",
+	"heat",
+	"heed",
+	"heel",
+	"heft",
+	"heir",
+	"held",
+	"hell",
+	"helm",
+	"help",
+	"hemp",
+	"hens",
+	"herb",
+	"herd",
+	"here",
+	"hero",
+	"hers",
+	"hewn",
+	"hick",
+	"hide",
+	"high",
+	"hike",
+	"hill",
+	"hilt",
+	"hind",
+	"hint",
+	"hips",
+	"hire",
+	"hiss",
+	"hits",
+	"hive",
+	"hoax",
+	"hobo",
+	"hoed",
+	"hogs",
+	"hold",
+	"hole",
+	"holy",
+	"home",
+	"hone",
+	"honk",
+	"hood",
+	"hoof",
+	"hook",
+	"hoop",
+	"hoot",
+	"hope",
+	"hops",
+	"horn",
+	"hose",
+	"host",
+	"hour",
+	"howl",
+	"hubs",
+	"hued",
+	"hues",
+	"huff",
+	"huge",
+	"hugs",
+	"hulk",
+	"hull",
+	"hump",
+	"hums",
+	"hung",
+	"hunt",
+	"hurt",
+	"husk",
+	"hymn",
+	"hype",
+	"iced",
+	"icky",
+	"icon",
+	"idea",
+	"idle",
+	"idly",
+	"idol",
+	"iffy",
+	"ills",
+	"imps",
+	"inch",
+	"inks",
+	"inky",
+	"inns",
+	"into",
+	"ions",
+	"iota",
+	"ires",
+	"irks",
+	"iron",
+	"isle",
+	"itch",
+	"item",
+	"jabs",
+	"jack",
+	"jade",
+	"jags",
+	"jail",
+	"jams",
+	"jars",
+	"jaws",
+	"jazz",
+	"jeer",
+	"jell",
+	"jerk",
+	"jest",
+	"jets",
+	"jibe",
+	"jigs",
+	"jilt",
+	"jinx",
+	"jive",
+	"jobs",
+	"jock",
+	"jogs",
+	"join",
+	"jolt",
+	"jots",
+	"jowl",
+	"joys",
+	"judo",
+	"jugs",
+	"juke",
+	"jump",
+	"jury",
+	"just",
+	"jute",
+	"kale",
+	"keel",
+	"keen",
+	"keep",
+	"kegs",
+	"kelp",
+	"kept",
+	"kick",
+	"kill",
+	"kiln",
+	"kilt",
+	"kind",
+	"king",
+	"kiss",
+	"kite",
+	"kits",
+	"kiwi",
+	"knee",
+	"knew",
+	"knit",
+	"knob",
+	"knot",
+	"know",
+	"kudo",
+	"lace",
+	"lack",
+	"lads",
+	"lady",
+	"lags",
+	"laid",
+	"lair",
+	"lake",
+	"lamb",
+	"lame",
+	"lamp",
+	"land",
+	"lane",
+	"lard",
+	"lark",
+	"lash",
+	"lass",
+	"last",
+	"late",
+	"lath",
+	"laud",
+	"lava",
+	"lawn",
+	"laws",
+	"lazy",
+	"lead",
+	"leaf",
+	"leak",
+	"lean",
+	"leap",
+	"leek",
+	"leer",
+	"left",
+	"legs",
+	"lend",
+	"lens",
+	"lent",
+	"less",
+	"levy",
+	"liar",
+	"lice",
+	"lick",
+	"lids",
+	"lied",
+	"lieu",
+	"life",
+	"lift",
+	"like",
+	"lily",
+	"limb",
+	"lime",
+	"limp",
+	"line",
+	"link",
+	"lint",
+	"lion",
+	"lips",
+	"lisp",
+	"list",
+	"lite",
+	"live",
+	"load",
+	"loan",
+	"lobe",
+	"lock",
+	"loft",
+	"logo",
+	"loin",
+	"long",
+	"look",
+	"loom",
+	"loop",
+	"loot",
+	"lope",
+	"lord",
+	"lore",
+	"lose",
+	"loss",
+	"lost",
+	"lots",
+	"loud",
+	"lout",
+	"love",
+	"lube",
+	"luck",
+	"lull",
+	"lump",
+	"lung",
+	"lure",
+	"lurk",
+	"lush",
+	"lust",
+	"lute",
+	"lynx",
+	"mace",
+	"mach",
+	"made",
+	"maid",
+	"mail",
+	"main",
+	"make",
+	"male",
+	"mane",
+	"many",
+	"maps",
+	"mare",
+	"mark",
+	"mart",
+	"mash",
+	"mask",
+	"mass",
+	"mast",
+	"mate",
+	"maul",
+	"maze",
+	"mead",
+	"meal",
+	"mean",
+	"meat",
+	"meek",
+	"meet",
+	"meld",
+	"melt",
+	"memo",
+	"mend",
+	"menu",
+	"mere",
+	"mesh",
+	"mess",
+	"mica",
+	"mice",
+	"mild",
+	"mile",
+	"milk",
+	"mill",
+	"mime",
+	"mind",
+	"mine",
+	"mink",
+	"mint",
+	"minx",
+	"mire",
+	"miss",
+	"mist",
+	"mite",
+	"mitt",
+	"moan",
+	"moat",
+	"mobs",
+	"mock",
+	"mode",
+	"mold",
+	"mole",
+	"molt",
+	"monk",
+	"mood",
+	"moon",
+	"mope",
+	"more",
+	"moss",
+	"most",
+	"moth",
+	"move",
+	"much",
+	"muck",
+	"muff",
+	"mugs",
+	"mule",
+	"mull",
+	"mums",
+	"murk",
+	"muse",
+	"mush",
+	"musk",
+	"must",
+	"mute",
+	"mutt",
+	"myth",
+	"nabs",
+	"nags",
+	"nail",
+	"name",
+	"nape",
+	"naps",
+	"nave",
+	"navy",
+	"near",
+	"neck",
+	"need",
+	"nerd",
+	"nest",
+	"nets",
+	"news",
+	"newt",
+	"next",
+	"nibs",
+	"nice",
+	"nigh",
+	"nine",
+	"nips",
+	"node",
+	"nods",
+	"noel",
+	"none",
+	"nook",
+	"noon",
+	"norm",
+	"nose",
+	"nosy",
+	"note",
+	"nuke",
+	"numb",
+	"nuns",
+	"nuts",
+	"oafs",
+	"oaks",
+	"oars",
+	"oath",
+	"oats",
+	"obey",
+	"oboe",
+	"odds",
+	"odes",
+	"ogle",
+	"ogre",
+	"oils",
+	"oily",
+	"oink",
+	"okay",
+	"omen",
+	"omit",
+	"once",
+	"ones",
+	"only",
+	"onto",
+	"ooze",
+	"oozy",
+	"opal",
+	"open",
+	"opts",
+	"opus",
+	"oral",
+	"orbs",
+	"orca",
+	"ores",
+	"ouch",
+	"ours",
+	"oust",
+	"outs",
+	"oval",
+	"oven",
+	"over",
+	"owed",
+	"owes",
+	"owls",
+	"owns",
+	"pace",
+	"pack",
+	"pads",
+	"page",
+	"paid",
+	"pail",
+	"pain",
+	"pair",
+	"pale",
+	"pall",
+	"palm",
+	"pane",
+	"pang",
+	"pans",
+	"pant",
+	"pare",
+	"park",
+	"part",
+	"pass",
+	"past",
+	"path",
+	"pats",
+	"pave",
+	"pawn",
+	"paws",
+	"pays",
+	"peak",
+	"peal",
+	"pear",
+	"peas",
+	"peat",
+	"peck",
+	"peek",
+	"peel",
+	"peep",
+	"peer",
+	"pegs",
+	"pelt",
+	"pens",
+	"pent",
+	"perk",
+	"perm",
+	"pert",
+	"pest",
+	"pets",
+	"pews",
+	"pick",
+	"pier",
+	"pies",
+	"pigs",
+	"pike",
+	"pile",
+	"pill",
+	"pine",
+	"pink",
+	"pins",
+	"pint",
+	"pipe",
+	"pity",
+	"plan",
+	"play",
+	"plea",
+	"pled",
+	"plod",
+	"plop",
+	"plot",
+	"plow",
+	"ploy",
+	"plug",
+	"plum",
+	"plus",
+	"pods",
+	"poem",
+	"poet",
+	"poke",
+	"poky",
+	"pole",
+	"poll",
+	"pomp",
+	"pond",
+	"pony",
+	"poof",
+	"pooh",
+	"pool",
+	"poor",
+	"pops",
+	"pore",
+	"pork",
+	"port",
+	"pose",
+	"posh",
+	"post",
+	"pots",
+	"pouf",
+	"pour",
+	"pout",
+	"pram",
+	"pray",
+	"prep",
+	"prey",
+	"prim",
+	"prod",
+	"prom",
+	"prop",
+	"prow",
+	"pubs",
+	"puck",
+	"puff",
+	"pugs",
+	"puke",
+	"pull",
+	"pulp",
+	"puma",
+	"pump",
+	"punk",
+	"puns",
+	"pups",
+	"pure",
+	"purr",
+	"push",
+	"pyre",
+	"quad",
+	"quay",
+	"quip",
+	"quit",
+	"quiz",
+	"race",
+	"rack",
+	"racy",
+	"raft",
+	"rage",
+	"rags",
+	"raid",
+	"rail",
+	"rain",
+	"rake",
+	"ramp",
+	"rams",
+	"rang",
+	"rank",
+	"rant",
+	"raps",
+	"rare",
+	"rasp",
+	"rate",
+	"rats",
+	"rave",
+	"rays",
+	"raze",
+	"razz",
+	"read",
+	"real",
+	"reap",
+	"rear",
+	"reed",
+	"reef",
+	"reek",
+	"reel",
+	"rein",
+	"rely",
+	"rend",
+	"rent",
+	"rest",
+	"rice",
+	"rich",
+	"ride",
+	"rife",
+	"rift",
+	"rigs",
+	"rile",
+	"rims",
+	"rind",
+	"ring",
+	"rink",
+	"riot",
+	"ripe",
+	"rips",
+	"rise",
+	"risk",
+	"road",
+	"roam",
+	"roar",
+	"robe",
+	"rock",
+	"rods",
+	"role",
+	"roll",
+	"romp",
+	"roof",
+	"rook",
+	"room",
+	"root",
+	"rope",
+	"rose",
+	"rosy",
+	"rote",
+	"rots",
+	"rout",
+	"rove",
+	"rows",
+	"rubs",
+	"ruby",
+	"rude",
+	"rued",
+	"rues",
+	"ruff",
+	"rugs",
+	"ruin",
+	"rule",
+	"rump",
+	"rung",
+	"runt",
+	"ruse",
+	"rush",
+	"rust",
+	"ruts",
+	"sack",
+	"sacs",
+	"safe",
+	"saga",
+	"sage",
+	"sags",
+	"said",
+	"sail",
+	"sake",
+	"sale",
+	"salt",
+	"same",
+	"sand",
+	"sane",
+	"sang",
+	"sank",
+	"saps",
+	"sash",
+	"sass",
+	"sate",
+	"save",
+	"scab",
+	"scam",
+	"scan",
+	"scar",
+	"seal",
+	"seam",
+	"sear",
+	"seat",
+	"sect",
+	"seed",
+	"seek",
+	"seem",
+	"seen",
+	"seep",
+	"self",
+	"sell",
+	"send",
+	"sent",
+	"sewn",
+	"sham",
+	"shed",
+	"shim",
+	"shin",
+	"ship",
+	"shoe",
+	"shoo",
+	"shop",
+	"shot",
+	"show",
+	"shun",
+	"shut",
+	"sick",
+	"side",
+	"sift",
+	"sigh",
+	"sign",
+	"silk",
+	"sill",
+	"silo",
+	"silt",
+	"sing",
+	"sink",
+	"sips",
+	"sire",
+	"site",
+	"sits",
+	"size",
+	"skew",
+	"skid",
+	"skim",
+	"skin",
+	"skip",
+	"skis",
+	"slab",
+	"slam",
+	"slap",
+	"slat",
+	"slaw",
+	"sled",
+	"slew",
+	"slid",
+	"slim",
+	"slip",
+	"slit",
+	"slob",
+	"slog",
+	"slop",
+	"slot",
+	"slow",
+	"slug",
+	"slum",
+	"slur",
+	"smog",
+	"smug",
+	"snag",
+	"snap",
+	"snip",
+	"snob",
+	"snot",
+	"snow",
+	"snub",
+	"snug",
+	"soak",
+	"soap",
+	"soar",
+	"sobs",
+	"sock",
+	"soda",
+	"sofa",
+	"soft",
+	"soil",
+	"sold",
+	"sole",
+	"some",
+	"song",
+	"soon",
+	"soot",
+	"sore",
+	"sort",
+	"soul",
+	"sour",
+	"sown",
+	"soya",
+	"span",
+	"spar",
+	"spat",
+	"spay",
+	"spec",
+	"sped",
+	"spew",
+	"spin",
+	"spit",
+	"spot",
+	"spud",
+	"spun",
+	"spur",
+	"stab",
+	"stag",
+	"star",
+	"stay",
+	"stem",
+	"step",
+	"stew",
+	"stir",
+	"stop",
+	"stub",
+	"stud",
+	"stun",
+	"subs",
+	"such",
+	"suck",
+	"suds",
+	"sued",
+	"sues",
+	"suit",
+	"sulk",
+	"sumo",
+	"sums",
+	"sung",
+	"sunk",
+	"sure",
+	"swab",
+	"swag",
+	"swam",
+	"swan",
+	"swap",
+	"swat",
+	"sway",
+	"swig",
+	"swim",
+	"tabs",
+	"tack",
+	"taco",
+	"tact",
+	"tags",
+	"tail",
+	"take",
+	"tale",
+	"talk",
+	"tall",
+	"tame",
+	"tang",
+	"tank",
+	"tape",
+	"taps",
+	"tarp",
+	"tart",
+	"task",
+	"taut",
+	"taxi",
+	"teak",
+	"teal",
+	"team",
+	"teas",
+	"tech",
+	"teem",
+	"teen",
+	"tees",
+	"tell",
+	"tend",
+	"tent",
+	"term",
+	"tern",
+	"test",
+	"text",
+	"than",
+	"that",
+	"thaw",
+	"thee",
+	"them",
+	"then",
+	"they",
+	"thin",
+	"this",
+	"thud",
+	"thug",
+	"thus",
+	"tick",
+	"tics",
+	"tide",
+	"tidy",
+	"tied",
+	"tier",
+	"ties",
+	"tile",
+	"till",
+	"tilt",
+	"time",
+	"tint",
+	"tiny",
+	"tips",
+	"tire",
+	"toad",
+	"tofu",
+	"toga",
+	"toil",
+	"told",
+	"toll",
+	"tomb",
+	"tome",
+	"tone",
+	"tons",
+	"took",
+	"tool",
+	"tops",
+	"tore",
+	"torn",
+	"toss",
+	"tote",
+	"tots",
+	"tour",
+	"tout",
+	"town",
+	"tows",
+	"toys",
+	"tram",
+	"trap",
+	"tray",
+	"tree",
+	"trek",
+	"trim",
+	"trio",
+	"trip",
+	"trod",
+	"trot",
+	"true",
+	"tuba",
+	"tube",
+	"tubs",
+	"tuck",
+	"tuft",
+	"tugs",
+	"tuna",
+	"tune",
+	"turn",
+	"tusk",
+	"tutu",
+	"twig",
+	"twin",
+	"twit",
+	"type",
+	"typo",
+	"ugly",
+	"undo",
+	"unit",
+	"unto",
+	"upon",
+	"urge",
+	"urns",
+	"used",
+	"user",
+	"uses",
+	"vain",
+	"vale",
+	"vamp",
+	"vane",
+	"vans",
+	"vary",
+	"vase",
+	"vast",
+	"veal",
+	"veer",
+	"veil",
+	"vein",
+	"vent",
+	"verb",
+	"very",
+	"vest",
+	"veto",
+	"vets",
+	"vial",
+	"vibe",
+	"vice",
+	"vied",
+	"vies",
+	"view",
+	"vile",
+	"vine",
+	"visa",
+	"void",
+	"vole",
+	"volt",
+	"vote",
+	"vows",
+	"wade",
+	"wads",
+	"waft",
+	"wage",
+	"wags",
+	"waif",
+	"wail",
+	"wait",
+	"wake",
+	"walk",
+	"wall",
+	"wand",
+	"wane",
+	"wank",
+	"want",
+	"ward",
+	"warm",
+	"warn",
+	"warp",
+	"wars",
+	"wart",
+	"wary",
+	"wash",
+	"wasp",
+	"watt",
+	"wave",
+	"wavy",
+	"waxy",
+	"ways",
+	"weak",
+	"wear",
+	"weds",
+	"weed",
+	"week",
+	"weep",
+	"weld",
+	"well",
+	"went",
+	"wept",
+	"were",
+	"west",
+	"what",
+	"when",
+	"whim",
+	"whip",
+	"whir",
+	"whiz",
+	"whom",
+	"wick",
+	"wide",
+	"wife",
+	"wigs",
+	"wild",
+	"will",
+	"wilt",
+	"wily",
+	"wimp",
+	"wind",
+	"wine",
+	"wing",
+	"wink",
+	"wins",
+	"wipe",
+	"wire",
+	"wiry",
+	"wise",
+	"wish",
+	"with",
+	"wits",
+	"woes",
+	"woke",
+	"woks",
+	"wolf",
+	"womb",
+	"wont",
+	"wood",
+	"wool",
+	"woos",
+	"word",
+	"wore",
+	"work",
+	"wrap",
+	"wren",
+	"writ",
+	"yaks",
+	"yams",
+	"yank",
+	"yaps",
+	"yard",
+	"yarn",
+	"yawn",
+	"yeah",
+	"year",
+	"yeti",
+	"yoga",
+	"yoke",
+	"yolk",
+	"yore",
+	"your",
+	"yowl",
+	"yuck",
+	"yule",
+	"zany",
+	"zaps",
+	"zeal",
+	"zero",
+	"zest",
+	"zinc",
+	"zing",
+	"zips",
+	"zits",
+	"zone",
+	"zoom",
+	"zoos",
+}
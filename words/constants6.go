@@ -0,0 +1,3077 @@
+package words
+
+var words6 = []string{
+	"comedy",
+	"engage",
+	"puzzle",
+	"resort",
+	"occupy",
+	"expand",
+	"source",
+	"window",
+	"autumn",
+	"bottle",
+	"garden",
+	"ladder",
+	"unique",
+	"refuse",
+	"employ",
+	"always",
+	"minute",
+	"behind",
+	"afford",
+	"unlike",
+	"remain",
+	"yellow",
+	"saying",
+	"import",
+	"canvas",
+	"holder",
+	"easily",
+	"defeat",
+	"pretty",
+	"notice",
+	"string",
+	"column",
+	"cookie",
+	"acting",
+	"prince",
+	"bucket",
+	"bundle",
+	"wallet",
+	"proven",
+	"garage",
+	"lizard",
+	"detail",
+	"rhythm",
+	"surely",
+	"flying",
+	"attend",
+	"permit",
+	"commit",
+	"wealth",
+	"enable",
+	"animal",
+	"record",
+	"couple",
+	"margin",
+	"behave",
+	"eleven",
+	"donkey",
+	"rescue",
+	"series",
+	"denial",
+	"combat",
+	"button",
+	"weight",
+	"repair",
+	"career",
+	"mother",
+	"circle",
+	"riding",
+	"desert",
+	"become",
+	"female",
+	"cherry",
+	"junior",
+	"change",
+	"energy",
+	"letter",
+	"church",
+	"relief",
+	"assign",
+	"prefer",
+	"attack",
+	"hunger",
+	"nobody",
+	"summer",
+	"socket",
+	"retain",
+	"repeat",
+	"corner",
+	"motion",
+	"stolen",
+	"format",
+	"divide",
+	"harbor",
+	"turkey",
+	"bishop",
+	"better",
+	"beauty",
+	"spring",
+	"escape",
+	"reason",
+	"anchor",
+	"galaxy",
+	"rabbit",
+	"reward",
+	"effort",
+	"cruise",
+	"charge",
+	"strict",
+	"season",
+	"tomato",
+	"anyone",
+	"across",
+	"little",
+	"weapon",
+	"create",
+	"nature",
+	"length",
+	"choice",
+	"winter",
+	"borrow",
+	"regard",
+	"drawer",
+	"insect",
+	"winner",
+	"doctor",
+	"vendor",
+	"phrase",
+	"unless",
+	"knight",
+	"master",
+	"aspect",
+	"jersey",
+	"formal",
+	"wisdom",
+	"bother",
+	"output",
+	"worker",
+	"offend",
+	"slight",
+	"wonder",
+	"gender",
+	"prison",
+	"native",
+	"leader",
+	"affair",
+	"client",
+	"cancel",
+	"device",
+	"rather",
+	"screen",
+	"famous",
+	"potato",
+	"carpet",
+	"spider",
+	"extent",
+	"random",
+	"museum",
+	"critic",
+	"butter",
+	"oxygen",
+	"forget",
+	"figure",
+	"design",
+	"branch",
+	"behalf",
+	"pepper",
+	"period",
+	"origin",
+	"freeze",
+	"impact",
+	"insist",
+	"object",
+	"fossil",
+	"chosen",
+	"either",
+	"strong",
+	"kidney",
+	"advise",
+	"signal",
+	"spirit",
+	"arctic",
+	"arrest",
+	"recent",
+	"future",
+	"lights",
+	"hunter",
+	"parent",
+	"sample",
+	"luxury",
+	"vision",
+	"really",
+	"broken",
+	"update",
+	"silent",
+	"expect",
+	"cheese",
+	"symbol",
+	"jacket",
+	"farmer",
+	"street",
+	"desire",
+	"indeed",
+	"lawyer",
+	"anyway",
+	"dealer",
+	"engine",
+	"during",
+	"proper",
+	"filter",
+	"temple",
+	"common",
+	"office",
+	"priest",
+	"rarely",
+	"number",
+	"growth",
+	"nation",
+	"domain",
+	"empire",
+	"mainly",
+	"intend",
+	"barely",
+	"strand",
+	"itself",
+	"stroke",
+	"liquid",
+	"select",
+	"debate",
+	"recall",
+	"tenant",
+	"enough",
+	"assert",
+	"editor",
+	"flight",
+	"pickle",
+	"recipe",
+	"patent",
+	"tender",
+	"access",
+	"closed",
+	"custom",
+	"celery",
+	"secret",
+	"threat",
+	"thread",
+	"basket",
+	"search",
+	"degree",
+	"family",
+	"useful",
+	"accept",
+	"marble",
+	"locate",
+	"ticket",
+	"planet",
+	"should",
+	"reader",
+	"finger",
+	"speech",
+	"closer",
+	"supply",
+	"tunnel",
+	"honest",
+	"bubble",
+	"define",
+	"soccer",
+	"bronze",
+	"volume",
+	"stream",
+	"infant",
+	"ending",
+	"worthy",
+	"mutual",
+	"palace",
+	"hardly",
+	"gather",
+	"bottom",
+	"fairly",
+	"belong",
+	"profit",
+	"submit",
+	"emerge",
+	"forest",
+	"suffer",
+	"appeal",
+	"league",
+	"plenty",
+	"eighty",
+	"rubber",
+	"likely",
+	"invest",
+	"copper",
+	"course",
+	"school",
+	"agenda",
+	"ethnic",
+	"foster",
+	"follow",
+	"global",
+	"decade",
+	"defend",
+	"treaty",
+	"retail",
+	"within",
+	"almost",
+	"script",
+	"demand",
+	"entity",
+	"wooden",
+	"artist",
+	"listen",
+	"nearby",
+	"decide",
+	"strain",
+	"studio",
+	"rating",
+	"relate",
+	"simple",
+	"retire",
+	"summit",
+	"admire",
+	"heaven",
+	"result",
+	"damage",
+	"moment",
+	"stable",
+	"zipper",
+	"august",
+	"merely",
+	"border",
+	"killer",
+	"policy",
+	"modest",
+	"assess",
+	"garlic",
+	"govern",
+	"pursue",
+	"answer",
+	"before",
+	"single",
+	"modern",
+	"amount",
+	"bridge",
+	"double",
+	"matter",
+	"cotton",
+	"mobile",
+	"bright",
+	"tissue",
+	"please",
+	"became",
+	"coming",
+	"gentle",
+	"assume",
+	"sudden",
+	"tennis",
+	"smooth",
+	"active",
+	"mental",
+	"remove",
+	"budget",
+	"walker",
+	"arrive",
+	"review",
+	"reduce",
+	"entire",
+	"estate",
+	"orange",
+	"belief",
+	"lesson",
+	"member",
+	"agency",
+	"carrot",
+	"travel",
+	"friend",
+	"mirror",
+	"accuse",
+	"launch",
+	"sphere",
+	"buffer",
+	"powder",
+	"safety",
+	"sister",
+	"myself",
+	"dragon",
+	"salmon",
+	"remote",
+	"partly",
+	"exceed",
+	"status",
+	"bureau",
+	"nephew",
+	"equity",
+	"driver",
+	"genius",
+	"monkey",
+	"square",
+	"health",
+	"spread",
+	"saddle",
+	"breath",
+	"happen",
+	"ground",
+	"nearly",
+	"golden",
+	"shower",
+	"talent",
+	"guitar",
+	"memory",
+	"finish",
+	"fellow",
+	"credit",
+	"sector",
+	"eating",
+	"salary",
+	"hidden",
+	"crisis",
+	"strike",
+	"ensure",
+	"report",
+	"timber",
+	"cousin",
+	"bitter",
+	"island",
+	"advice",
+	"adjust",
+	"candle",
+	"settle",
+	"twelve",
+	"hammer",
+	"frozen",
+	"dancer",
+	"option",
+	"sketch",
+	"cement",
+	"author",
+	"father",
+	"return",
+	"person",
+	"dinner",
+	"ginger",
+	"flower",
+	"income",
+	"attach",
+	"render",
+	"survey",
+	"rocket",
+	"region",
+	"shadow",
+	"fourth",
+	"senior",
+	"steady",
+	"market",
+	"throne",
+	"resist",
+	"county",
+	"former",
+	"battle",
+	"factor",
+	"wizard",
+	"manage",
+	"fiscal",
+	"castle",
+	"appear",
+	"tongue",
+	"thirty",
+	"bloody",
+	"depend",
+	"extend",
+	"insert",
+	"except",
+	"evolve",
+	"direct",
+	"method",
+	"middle",
+	"voyage",
+	"social",
+	"latter",
+	"effect",
+	"narrow",
+	"actual",
+	"buying",
+	"reform",
+	"valley",
+	"action",
+	"writer",
+	"people",
+	"severe",
+	"secure",
+	"sacred",
+	"choose",
+	"manner",
+	"bought",
+	"coffee",
+	"guilty",
+	"excuse",
+	"centre",
+	"pocket",
+	"dollar",
+	"danger",
+	"normal",
+	"toilet",
+	"beside",
+	"chance",
+	"injury",
+	"online",
+	"shield",
+	"victim",
+	"avenue",
+	"beyond",
+	"lovely",
+	"player",
+	"medium",
+	"second",
+	"handle",
+	"visual",
+	"around",
+	"toward",
+	"export",
+	"system",
+	"poetry",
+	"fallen",
+	"backed",
+	"center",
+	"living",
+	"fabric",
+	"weekly",
+	"silver",
+	"height",
+	"reveal",
+	"police",
+	"casual",
+	"obtain",
+	"scheme",
+	"carbon",
+	"inside",
+	"expert",
+	"caught",
+	"ribbon",
+	"facing",
+	"target",
+	"jungle",
+	"annual",
+	"muscle",
+	"public",
+	"stress",
+	"deputy",
+	"absent",
+	"assist",
+	"inform",
+	"switch",
+	"burden",
+}
+
+var valid6 = []string{
+	"abbeys",
+	"abduct",
+	"abided",
+	"abides",
+	"abject",
+	"ablaze",
+	"aboard",
+	"abound",
+	"abrupt",
+	"absent",
+	"absorb",
+	"abuser",
+	"abuses",
+	"accent",
+	"accept",
+	"access",
+	"accuse",
+	"acquit",
+	"across",
+	"acting",
+	"action",
+	"active",
+	"actual",
+	"adhere",
+	"adjust",
+	"admire",
+	"admits",
+	"adored",
+	"adorer",
+	"adrift",
+	"advent",
+	"advice",
+	"advise",
+	"aerial",
+	"affair",
+	"affirm",
+	"afford",
+	"afloat",
+	"afraid",
+	"agency",
+	"agenda",
+	"agreed",
+	"agrees",
+	"aiming",
+	"airbag",
+	"airing",
+	"aisles",
+	"alarms",
+	"albeit",
+	"albums",
+	"alerts",
+	"aliens",
+	"allege",
+	"allied",
+	"allies",
+	"allure",
+	"almond",
+	"almost",
+	"alpine",
+	"alters",
+	"always",
+	"amazed",
+	"amazes",
+	"ambush",
+	"amends",
+	"amount",
+	"ampler",
+	"amulet",
+	"amused",
+	"anchor",
+	"anemia",
+	"angels",
+	"angled",
+	"angler",
+	"angles",
+	"angora",
+	"animal",
+	"ankles",
+	"annoys",
+	"annual",
+	"anoint",
+	"answer",
+	"antics",
+	"antler",
+	"anvils",
+	"anyhow",
+	"anyone",
+	"anyway",
+	"apathy",
+	"apiece",
+	"aplomb",
+	"appall",
+	"appeal",
+	"appear",
+	"arcade",
+	"arched",
+	"arches",
+	"arctic",
+	"ardent",
+	"argued",
+	"argues",
+	"armies",
+	"armpit",
+	"around",
+	"arouse",
+	"arrays",
+	"arrest",
+	"arrive",
+	"arrows",
+	"artist",
+	"ascend",
+	"ashore",
+	"asking",
+	"asleep",
+	"aspect",
+	"aspire",
+	"assert",
+	"assess",
+	"assets",
+	"assign",
+	"assist",
+	"assume",
+	"assure",
+	"asylum",
+	"atoned",
+	"attach",
+	"attack",
+	"attain",
+	"attend",
+	"attest",
+	"attire",
+	"attune",
+	"auburn",
+	"audits",
+	"augurs",
+	"august",
+	"author",
+	"autumn",
+	"avenue",
+	"aviary",
+	"avidly",
+	"awaken",
+	"awards",
+	"awhile",
+	"babies",
+	"backed",
+	"badger",
+	"baffle",
+	"bagels",
+	"bakery",
+	"baking",
+	"ballet",
+	"ballot",
+	"bamboo",
+	"banana",
+	"bandit",
+	"banish",
+	"banker",
+	"banner",
+	"banter",
+	"barber",
+	"barely",
+	"barged",
+	"barley",
+	"barrel",
+	"barren",
+	"barter",
+	"basics",
+	"basins",
+	"basket",
+	"batter",
+	"battle",
+	"bawled",
+	"beacon",
+	"beaded",
+	"beagle",
+	"beaker",
+	"beasts",
+	"beaten",
+	"beauty",
+	"beaver",
+	"became",
+	"beckon",
+	"become",
+	"bedbug",
+	"beetle",
+	"befall",
+	"before",
+	"beggar",
+	"begins",
+	"behalf",
+	"behave",
+	"behind",
+	"behold",
+	"belief",
+	"bellow",
+	"belong",
+	"belted",
+	"bemoan",
+	"berate",
+	"berets",
+	"beside",
+	"betray",
+	"better",
+	"beyond",
+	"bicker",
+	"bidder",
+	"bikini",
+	"binder",
+	"biopsy",
+	"birdie",
+	"births",
+	"bishop",
+	"biting",
+	"bitter",
+	"blamed",
+	"blazer",
+	"blends",
+	"blinds",
+	"blocks",
+	"blonde",
+	"bloody",
+	"blouse",
+	"blower",
+	"blurry",
+	"boasts",
+	"bodily",
+	"boiler",
+	"bolder",
+	"boldly",
+	"bonbon",
+	"bonnet",
+	"bonsai",
+	"booked",
+	"bookie",
+	"boosts",
+	"booths",
+	"border",
+	"borrow",
+	"bosses",
+	"botany",
+	"bother",
+	"bottle",
+	"bottom",
+	"bought",
+	"bounce",
+	"bounty",
+	"bovine",
+	"bowler",
+	"boxing",
+	"braced",
+	"braids",
+	"brains",
+	"brainy",
+	"braise",
+	"brakes",
+	"branch",
+	"brandy",
+	"brawny",
+	"brazen",
+	"breach",
+	"breath",
+	"breeze",
+	"brewer",
+	"bridal",
+	"bridge",
+	"briefs",
+	"bright",
+	"brings",
+	"broken",
+	"bronze",
+	"brooch",
+	"brooms",
+	"browse",
+	"bruise",
+	"brunch",
+	"brushy",
+	"brutal",
+	"bubble",
+	"bubbly",
+	"bucket",
+	"buckle",
+	"budded",
+	"budget",
+	"buffer",
+	"bugler",
+	"builds",
+	"bulged",
+	"bumped",
+	"bumper",
+	"bundle",
+	"bunker",
+	"burden",
+	"bureau",
+	"burger",
+	"burial",
+	"buried",
+	"burrow",
+	"busier",
+	"busted",
+	"bustle",
+	"butler",
+	"butter",
+	"button",
+	"buyers",
+	"buying",
+	"buzzer",
+	"bygone",
+	"cabins",
+	"cables",
+	"cactus",
+	"caddie",
+	"cadets",
+	"cagily",
+	"cajole",
+	"called",
+	"caller",
+	"callus",
+	"camera",
+	"campus",
+	"canary",
+	"cancel",
+	"candid",
+	"candle",
+	"canine",
+	"canned",
+	"cannon",
+	"canoes",
+	"canopy",
+	"canvas",
+	"canyon",
+	"capped",
+	"captor",
+	"carafe",
+	"carats",
+	"carbon",
+	"career",
+	"caress",
+	"carpet",
+	"carrot",
+	"carton",
+	"carved",
+	"carver",
+	"casino",
+	"castle",
+	"casual",
+	"cattle",
+	"caucus",
+	"caught",
+	"caveat",
+	"cavern",
+	"caving",
+	"celery",
+	"cement",
+	"center",
+	"centre",
+	"cereal",
+	"chalet",
+	"chalky",
+	"chance",
+	"change",
+	"chapel",
+	"charge",
+	"charms",
+	"chased",
+	"chaser",
+	"chasms",
+	"cheeky",
+	"cheers",
+	"cheese",
+	"cherry",
+	"chilly",
+	"chimes",
+	"chirpy",
+	"chisel",
+	"choice",
+	"choked",
+	"chokes",
+	"choose",
+	"chorus",
+	"chosen",
+	"chrome",
+	"chubby",
+	"chunky",
+	"church",
+	"cinder",
+	"cinema",
+	"cipher",
+	"circle",
+	"citrus",
+	"clammy",
+	"claque",
+	"clause",
+	"cleans",
+	"clever",
+	"cliche",
+	"clicks",
+	"client",
+	"climax",
+	"clinch",
+	"clingy",
+	"clinic",
+	"clique",
+	"closed",
+	"closer",
+	"closet",
+	"clothe",
+	"clouds",
+	"cloudy",
+	"clover",
+	"clumsy",
+	"clutch",
+	"coarse",
+	"coasts",
+	"coated",
+	"cobalt",
+	"cobweb",
+	"cocoon",
+	"coddle",
+	"coerce",
+	"coffee",
+	"coffin",
+	"cogent",
+	"coined",
+	"collar",
+	"colony",
+	"colors",
+	"column",
+	"combat",
+	"combed",
+	"comedy",
+	"comets",
+	"coming",
+	"commit",
+	"common",
+	"comply",
+	"condor",
+	"confer",
+	"convex",
+	"convey",
+	"convoy",
+	"cooked",
+	"cooker",
+	"cookie",
+	"cooled",
+	"cooler",
+	"copied",
+	"copier",
+	"coping",
+	"copper",
+	"corals",
+	"cordon",
+	"corked",
+	"cornea",
+	"corner",
+	"corpse",
+	"corral",
+	"corset",
+	"cosmic",
+	"cosmos",
+	"costly",
+	"cotton",
+	"coughs",
+	"counts",
+	"county",
+	"couple",
+	"coupon",
+	"course",
+	"cousin",
+	"covers",
+	"covert",
+	"coward",
+	"cowboy",
+	"coyote",
+	"cozily",
+	"crabby",
+	"cradle",
+	"crafty",
+	"cranes",
+	"cranky",
+	"crater",
+	"craved",
+	"crayon",
+	"creamy",
+	"crease",
+	"create",
+	"credit",
+	"creepy",
+	"crisis",
+	"critic",
+	"cruise",
+	"crunch",
+	"crusty",
+	"crypto",
+	"cuddle",
+	"cuddly",
+	"cupful",
+	"curfew",
+	"curing",
+	"curled",
+	"curler",
+	"cursed",
+	"cursor",
+	"curtsy",
+	"curved",
+	"custom",
+	"cutlet",
+	"cutter",
+	"cycled",
+	"cycles",
+	"cymbal",
+	"dabble",
+	"dagger",
+	"dahlia",
+	"dainty",
+	"damage",
+	"dancer",
+	"danger",
+	"dangle",
+	"dapper",
+	"daring",
+	"darken",
+	"darted",
+	"dashed",
+	"dating",
+	"dawned",
+	"dazzle",
+	"deadly",
+	"deafen",
+	"dealer",
+	"dearly",
+	"debate",
+	"decade",
+	"deceit",
+	"decent",
+	"decide",
+	"decode",
+	"decree",
+	"deduct",
+	"deemed",
+	"deepen",
+	"deeply",
+	"deface",
+	"defeat",
+	"defect",
+	"defend",
+	"defied",
+	"defies",
+	"define",
+	"deftly",
+	"degree",
+	"delete",
+	"delude",
+	"deluxe",
+	"demand",
+	"demise",
+	"demote",
+	"denial",
+	"denims",
+	"dental",
+	"dented",
+	"depend",
+	"depict",
+	"deploy",
+	"deport",
+	"depose",
+	"deputy",
+	"derive",
+	"desert",
+	"design",
+	"desire",
+	"detach",
+	"detail",
+	"detect",
+	"device",
+	"devote",
+	"devour",
+	"devout",
+	"dialog",
+	"diesel",
+	"differ",
+	"digest",
+	"digits",
+	"dilute",
+	"dimmed",
+	"dimple",
+	"dining",
+	"dinner",
+	"dipper",
+	"direct",
+	"direly",
+	"dismal",
+	"dismay",
+	"divers",
+	"divert",
+	"divide",
+	"divine",
+	"diving",
+	"docile",
+	"docked",
+	"doctor",
+	"dodged",
+	"dodger",
+	"dogged",
+	"dollar",
+	"domain",
+	"domino",
+	"donate",
+	"donkey",
+	"donors",
+	"doodle",
+	"doomed",
+	"dotted",
+	"double",
+	"doubts",
+	"dozens",
+	"drafty",
+	"dragon",
+	"drapes",
+	"drawer",
+	"dreamy",
+	"dreary",
+	"drench",
+	"dressy",
+	"drivel",
+	"driven",
+	"driver",
+	"drones",
+	"drowsy",
+	"drying",
+	"dubbed",
+	"duffel",
+	"dugout",
+	"dulled",
+	"dumped",
+	"duplex",
+	"duress",
+	"during",
+	"dusted",
+	"duster",
+	"dwarfs",
+	"dwells",
+	"dyeing",
+	"eagles",
+	"earned",
+	"earthy",
+	"easels",
+	"easier",
+	"easily",
+	"eating",
+	"echoed",
+	"echoes",
+	"eclair",
+	"edible",
+	"edited",
+	"editor",
+	"efface",
+	"effect",
+	"effort",
+	"eggnog",
+	"eighty",
+	"either",
+	"elapse",
+	"elated",
+	"elbows",
+	"elders",
+	"eldest",
+	"elects",
+	"eleven",
+	"elicit",
+	"eloped",
+	"embark",
+	"emblem",
+	"embryo",
+	"emerge",
+	"emoted",
+	"empire",
+	"employ",
+	"enable",
+	"enamel",
+	"encamp",
+	"encase",
+	"encore",
+	"endear",
+	"ending",
+	"endure",
+	"energy",
+	"enfold",
+	"engage",
+	"engine",
+	"engulf",
+	"enigma",
+	"enjoys",
+	"enlist",
+	"enough",
+	"enrage",
+	"enrich",
+	"enroll",
+	"ensign",
+	"ensure",
+	"entail",
+	"enters",
+	"entice",
+	"entire",
+	"entity",
+	"entree",
+	"envied",
+	"envies",
+	"enzyme",
+	"epochs",
+	"equals",
+	"equate",
+	"equity",
+	"erased",
+	"eraser",
+	"erects",
+	"eroded",
+	"errand",
+	"errant",
+	"ersatz",
+	"escape",
+	"escort",
+	"estate",
+	"etched",
+	"ethics",
+	"ethnic",
+	"evenly",
+	"evicts",
+	"evoked",
+	"evolve",
+	"exacts",
+	"exalts",
+	"exceed",
+	"excels",
+	"except",
+	"excuse",
+	"exempt",
+	"exhale",
+	"exhort",
+	"exiled",
+	"exotic",
+	"expand",
+	"expect",
+	"expert",
+	"expire",
+	"export",
+	"expose",
+	"extend",
+	"extent",
+	"extort",
+	"eyeing",
+	"eyelid",
+	"fabric",
+	"facade",
+	"facets",
+	"facial",
+	"facing",
+	"factor",
+	"fading",
+	"fairly",
+	"faking",
+	"fallen",
+	"falter",
+	"family",
+	"famine",
+	"famous",
+	"fanned",
+	"farces",
+	"farmed",
+	"farmer",
+	"fasten",
+	"father",
+	"fathom",
+	"faucet",
+	"fawned",
+	"feasts",
+	"feeble",
+	"feeder",
+	"feisty",
+	"fellow",
+	"female",
+	"fencer",
+	"fender",
+	"ferret",
+	"fervid",
+	"fervor",
+	"fester",
+	"fetish",
+	"fetter",
+	"fiance",
+	"fiasco",
+	"fibber",
+	"fickle",
+	"fiddle",
+	"fidget",
+	"fierce",
+	"fiesta",
+	"fifths",
+	"figure",
+	"filled",
+	"fillet",
+	"filter",
+	"filthy",
+	"finale",
+	"finely",
+	"finest",
+	"finger",
+	"finish",
+	"firing",
+	"firmly",
+	"fiscal",
+	"fitted",
+	"fixate",
+	"fizzle",
+	"flabby",
+	"flaked",
+	"flakes",
+	"flaunt",
+	"flavor",
+	"flawed",
+	"fleece",
+	"fleshy",
+	"flight",
+	"flimsy",
+	"flinch",
+	"flirty",
+	"floats",
+	"floods",
+	"floppy",
+	"floral",
+	"florid",
+	"floury",
+	"flower",
+	"fluent",
+	"fluffy",
+	"flukes",
+	"flunky",
+	"flurry",
+	"flying",
+	"foamed",
+	"fodder",
+	"foible",
+	"foiled",
+	"folded",
+	"folder",
+	"follow",
+	"fondle",
+	"fondly",
+	"fooled",
+	"forbid",
+	"forced",
+	"forego",
+	"forest",
+	"forged",
+	"forger",
+	"forget",
+	"forgot",
+	"forked",
+	"formal",
+	"format",
+	"formed",
+	"former",
+	"fortes",
+	"fossil",
+	"foster",
+	"fought",
+	"fouled",
+	"fourth",
+	"framed",
+	"frayed",
+	"freaky",
+	"freely",
+	"freeze",
+	"frenzy",
+	"fridge",
+	"friend",
+	"fright",
+	"frilly",
+	"fringe",
+	"frisky",
+	"frolic",
+	"frosty",
+	"frothy",
+	"frozen",
+	"frugal",
+	"fumble",
+	"funnel",
+	"furrow",
+	"fusion",
+	"futile",
+	"future",
+	"gabled",
+	"gadget",
+	"gaggle",
+	"gained",
+	"gaiter",
+	"galaxy",
+	"galore",
+	"gambit",
+	"gamble",
+	"gaming",
+	"gander",
+	"gapped",
+	"garage",
+	"garble",
+	"garden",
+	"gargle",
+	"garish",
+	"garlic",
+	"garter",
+	"gasket",
+	"gasped",
+	"gather",
+	"gauged",
+	"gazebo",
+	"geared",
+	"geezer",
+	"gender",
+	"genius",
+	"gentle",
+	"gently",
+	"geyser",
+	"ghosts",
+	"giants",
+	"giblet",
+	"giggle",
+	"gilded",
+	"ginger",
+	"girdle",
+	"glance",
+	"glazed",
+	"glided",
+	"glider",
+	"glitch",
+	"global",
+	"gloomy",
+	"glossy",
+	"gloved",
+	"gloves",
+	"glowed",
+	"gluten",
+	"gnarly",
+	"goblet",
+	"goblin",
+	"goggle",
+	"golden",
+	"golfer",
+	"gopher",
+	"gorged",
+	"gospel",
+	"gossip",
+	"gouged",
+	"gourds",
+	"govern",
+	"graced",
+	"grader",
+	"grainy",
+	"grated",
+	"grater",
+	"gravel",
+	"grazed",
+	"greasy",
+	"grieve",
+	"grille",
+	"grimly",
+	"grinch",
+	"griped",
+	"grippy",
+	"groans",
+	"groggy",
+	"groove",
+	"groped",
+	"grotto",
+	"grouch",
+	"ground",
+	"grovel",
+	"grower",
+	"growls",
+	"growth",
+	"grubby",
+	"grudge",
+	"grumpy",
+	"grunts",
+	"guards",
+	"guided",
+	"guilds",
+	"guilty",
+	"guises",
+	"guitar",
+	"gulped",
+	"gurgle",
+	"gusher",
+	"gutter",
+	"gypsum",
+	"hackle",
+	"haggle",
+	"hailed",
+	"halted",
+	"halved",
+	"hamlet",
+	"hammer",
+	"hamper",
+	"handed",
+	"handle",
+	"hanger",
+	"hankie",
+	"happen",
+	"haptic",
+	"harass",
+	"harbor",
+	"harden",
+	"hardly",
+	"harmed",
+	"harped",
+	"hassle",
+	"hating",
+	"hatred",
+	"hauled",
+	"haunts",
+	"hawker",
+	"hazard",
+	"headed",
+	"healer",
+	"health",
+	"heaped",
+	"hearth",
+	"hearty",
+	"heated",
+	"heater",
+	"heaven",
+	"heckle",
+	"hectic",
+	"hedged",
+	"heeded",
+	"height",
+	"helmet",
+	"helper",
+	"herbal",
+	"herded",
+	"hereby",
+	"heresy",
+	"hermit",
+	"heroic",
+	"herons",
+	"hiccup",
+	"hidden",
+	"hijack",
+	"hinder",
+	"hinged",
+	"hiring",
+	"hoards",
+	"hoarse",
+	"hobble",
+	"hockey",
+	"holder",
+	"holler",
+	"hollow",
+	"homage",
+	"honest",
+	"honked",
+	"hooded",
+	"hooked",
+	"hooray",
+	"hopper",
+	"horrid",
+	"hotbed",
+	"hourly",
+	"hovers",
+	"howled",
+	"huddle",
+	"hugely",
+	"humane",
+	"humbly",
+	"hummus",
+	"humped",
+	"hunger",
+	"hunted",
+	"hunter",
+	"hurdle",
+	"hurled",
+	"hurray",
+	"hurtle",
+	"hushed",
+	"husked",
+	"hustle",
+	"hybrid",
+	"hyphen",
+	"icicle",
+	"iconic",
+	"ideals",
+	"idiocy",
+	"idling",
+	"ignite",
+	"ignore",
+	"iguana",
+	"imbibe",
+	"immune",
+	"impact",
+	"impair",
+	"impale",
+	"impart",
+	"impede",
+	"impish",
+	"import",
+	"impose",
+	"impure",
+	"inched",
+	"incite",
+	"income",
+	"indeed",
+	"indent",
+	"indoor",
+	"induce",
+	"infant",
+	"inflow",
+	"inform",
+	"infuse",
+	"ingest",
+	"inhale",
+	"inject",
+	"injury",
+	"inkjet",
+	"inlaid",
+	"inland",
+	"inmate",
+	"innate",
+	"inputs",
+	"insane",
+	"inseam",
+	"insect",
+	"insert",
+	"inside",
+	"insist",
+	"instep",
+	"insult",
+	"intact",
+	"intake",
+	"intend",
+	"intern",
+	"intone",
+	"invade",
+	"invent",
+	"invest",
+	"invite",
+	"invoke",
+	"inward",
+	"irises",
+	"ironic",
+	"island",
+	"itches",
+	"itself",
+	"jabbed",
+	"jacket",
+	"jagged",
+	"jaguar",
+	"jailer",
+	"jargon",
+	"jaunty",
+	"jersey",
+	"jester",
+	"jetlag",
+	"jigsaw",
+	"jingle",
+	"jitter",
+	"jockey",
+	"jogger",
+	"jolted",
+	"jostle",
+	"jotted",
+	"jovial",
+	"joyful",
+	"judged",
+	"juggle",
+	"juiced",
+	"jumble",
+	"jumper",
+	"jungle",
+	"junior",
+	"juries",
+	"justly",
+	"kennel",
+	"kernel",
+	"kettle",
+	"kicker",
+	"kidnap",
+	"kidney",
+	"killer",
+	"kindle",
+	"kindly",
+	"kisser",
+	"kitten",
+	"knight",
+	"knotty",
+	"kosher",
+	"lacing",
+	"ladder",
+	"lagoon",
+	"lament",
+	"lander",
+	"lapsed",
+	"laptop",
+	"larder",
+	"lariat",
+	"lashed",
+	"lasted",
+	"lately",
+	"lather",
+	"latter",
+	"launch",
+	"laurel",
+	"lavish",
+	"lawyer",
+	"layout",
+	"leaded",
+	"leader",
+	"league",
+	"leaked",
+	"leaned",
+	"leaped",
+	"learnt",
+	"leased",
+	"leaves",
+	"ledger",
+	"legacy",
+	"legend",
+	"legion",
+	"lender",
+	"length",
+	"lentil",
+	"lesser",
+	"lesson",
+	"lethal",
+	"letter",
+	"levity",
+	"lichen",
+	"lifted",
+	"lights",
+	"likely",
+	"lilacs",
+	"limber",
+	"limped",
+	"linear",
+	"linger",
+	"lining",
+	"linked",
+	"lintel",
+	"lipids",
+	"liquid",
+	"lisped",
+	"listen",
+	"litter",
+	"little",
+	"lively",
+	"livery",
+	"living",
+	"lizard",
+	"loaded",
+	"loafer",
+	"loathe",
+	"lobbed",
+	"locate",
+	"locker",
+	"locket",
+	"lodged",
+	"lofted",
+	"logged",
+	"loiter",
+	"lonely",
+	"longed",
+	"loosen",
+	"looted",
+	"lopped",
+	"lordly",
+	"lotion",
+	"louder",
+	"lounge",
+	"louvre",
+	"lovely",
+	"lovers",
+	"lowest",
+	"lubber",
+	"lucked",
+	"lumber",
+	"lunacy",
+	"lunged",
+	"lurked",
+	"lusted",
+	"lustre",
+	"luxury",
+	"lyrics",
+	"madden",
+	"madman",
+	"maggot",
+	"magnet",
+	"maiden",
+	"mailed",
+	"mainly",
+	"makers",
+	"malice",
+	"mallet",
+	"mammal",
+	"manage",
+	"mangle",
+	"manner",
+	"mantel",
+	"mantle",
+	"manual",
+	"maples",
+	"marble",
+	"margin",
+	"marina",
+	"marked",
+	"marker",
+	"market",
+	"marrow",
+	"marvel",
+	"mascot",
+	"mashed",
+	"masked",
+	"masons",
+	"massed",
+	"master",
+	"mating",
+	"matted",
+	"matter",
+	"mature",
+	"mauled",
+	"meadow",
+	"meager",
+	"meddle",
+	"medium",
+	"medley",
+	"mellow",
+	"melody",
+	"melted",
+	"member",
+	"memory",
+	"menace",
+	"mended",
+	"mental",
+	"mentor",
+	"merely",
+	"merged",
+	"merger",
+	"merits",
+	"meshed",
+	"messed",
+	"metals",
+	"meteor",
+	"method",
+	"mettle",
+	"midday",
+	"middle",
+	"midget",
+	"mighty",
+	"mildew",
+	"mildly",
+	"milked",
+	"miller",
+	"mimosa",
+	"minded",
+	"miners",
+	"mingle",
+	"minion",
+	"minnow",
+	"minted",
+	"minute",
+	"mirror",
+	"mishap",
+	"misled",
+	"missed",
+	"misted",
+	"mitten",
+	"mobile",
+	"mocked",
+	"modern",
+	"modest",
+	"modify",
+	"molded",
+	"molten",
+	"moment",
+	"monkey",
+	"mooing",
+	"moored",
+	"mopped",
+	"morale",
+	"morbid",
+	"morsel",
+	"mortar",
+	"mosaic",
+	"mostly",
+	"mother",
+	"motion",
+	"moused",
+	"mousse",
+	"mouths",
+	"muddle",
+	"muffin",
+	"muffle",
+	"mugged",
+	"mulled",
+	"mumble",
+	"murmur",
+	"muscle",
+	"museum",
+	"musing",
+	"musket",
+	"mussel",
+	"muster",
+	"mutual",
+	"muzzle",
+	"myopic",
+	"myself",
+	"mystic",
+	"nabbed",
+	"nagged",
+	"napkin",
+	"napped",
+	"narrow",
+	"nation",
+	"native",
+	"nature",
+	"nausea",
+	"nearby",
+	"nearer",
+	"nearly",
+	"neatly",
+	"nectar",
+	"needed",
+	"needle",
+	"nephew",
+	"nestle",
+	"nettle",
+	"neuron",
+	"neuter",
+	"nibble",
+	"nickel",
+	"nimble",
+	"nipped",
+	"nipple",
+	"nitwit",
+	"nobody",
+	"noodle",
+	"normal",
+	"notary",
+	"notice",
+	"notion",
+	"novice",
+	"nozzle",
+	"nuance",
+	"nugget",
+	"number",
+	"nutmeg",
+	"nutria",
+	"object",
+	"oblige",
+	"oboist",
+	"obsess",
+	"obtain",
+	"obtuse",
+	"occult",
+	"occupy",
+	"oddity",
+	"offend",
+	"office",
+	"offset",
+	"ogling",
+	"oldest",
+	"onions",
+	"online",
+	"onward",
+	"oodles",
+	"opaque",
+	"opener",
+	"openly",
+	"oppose",
+	"optics",
+	"option",
+	"orange",
+	"orbits",
+	"orchid",
+	"ordain",
+	"orders",
+	"origin",
+	"ornate",
+	"orphan",
+	"osprey",
+	"ounces",
+	"outcry",
+	"outfit",
+	"outlaw",
+	"outlet",
+	"output",
+	"outrun",
+	"outwit",
+	"overdo",
+	"overly",
+	"owning",
+	"oxygen",
+	"oyster",
+	"padded",
+	"paddle",
+	"pagoda",
+	"paired",
+	"palace",
+	"palate",
+	"paling",
+	"palled",
+	"pamper",
+	"panels",
+	"panned",
+	"parade",
+	"parcel",
+	"pardon",
+	"parent",
+	"parish",
+	"parlor",
+	"parody",
+	"parrot",
+	"parsec",
+	"parsed",
+	"parted",
+	"partly",
+	"pastel",
+	"pastry",
+	"patchy",
+	"patent",
+	"patrol",
+	"patron",
+	"patted",
+	"paused",
+	"paving",
+	"pawned",
+	"payday",
+	"peachy",
+	"peaked",
+	"pealed",
+	"peanut",
+	"pebble",
+	"pecked",
+	"pedals",
+	"peddle",
+	"peeled",
+	"peered",
+	"pelvis",
+	"pencil",
+	"penned",
+	"people",
+	"pepper",
+	"period",
+	"perish",
+	"permit",
+	"person",
+	"pester",
+	"petals",
+	"petite",
+	"petrol",
+	"pewter",
+	"phased",
+	"phobia",
+	"phrase",
+	"picked",
+	"picket",
+	"pickle",
+	"picnic",
+	"pierce",
+	"piglet",
+	"pilfer",
+	"pillar",
+	"pillow",
+	"pimple",
+	"pincer",
+	"pinned",
+	"piracy",
+	"pirate",
+	"pistol",
+	"piston",
+	"pitied",
+	"pities",
+	"pixels",
+	"placid",
+	"plague",
+	"plaice",
+	"plaits",
+	"planet",
+	"plaque",
+	"plated",
+	"plates",
+	"player",
+	"pleads",
+	"please",
+	"pleats",
+	"pledge",
+	"plenty",
+	"pliers",
+	"plight",
+	"plucky",
+	"plunge",
+	"plural",
+	"plushy",
+	"pocket",
+	"poetry",
+	"police",
+	"policy",
+	"poodle",
+	"poorly",
+	"popped",
+	"poppet",
+	"porous",
+	"portal",
+	"posing",
+	"posses",
+	"possum",
+	"posted",
+	"poster",
+	"potato",
+	"potent",
+	"potion",
+	"potted",
+	"pounce",
+	"poured",
+	"pouted",
+	"powder",
+	"powers",
+	"prance",
+	"prawns",
+	"prayer",
+	"preach",
+	"prefer",
+	"prefix",
+	"pretty",
+	"prided",
+	"priest",
+	"primal",
+	"primed",
+	"prince",
+	"prints",
+	"prison",
+	"prissy",
+	"privet",
+	"prized",
+	"probed",
+	"profit",
+	"proofs",
+	"propel",
+	"proper",
+	"proven",
+	"prowls",
+	"prying",
+	"pseudo",
+	"public",
+	"pucker",
+	"puddle",
+	"puffed",
+	"pulley",
+	"pulpit",
+	"pumice",
+	"pummel",
+	"punchy",
+	"pundit",
+	"punish",
+	"pupils",
+	"puppet",
+	"purely",
+	"purist",
+	"purple",
+	"purred",
+	"pursed",
+	"pursue",
+	"pushed",
+	"putter",
+	"puzzle",
+	"quaint",
+	"quaked",
+	"quarry",
+	"quartz",
+	"quasar",
+	"queasy",
+	"quench",
+	"quiche",
+	"quiver",
+	"quoted",
+	"rabbit",
+	"rabies",
+	"racing",
+	"racket",
+	"radars",
+	"radish",
+	"raffle",
+	"rafter",
+	"ragged",
+	"raided",
+	"raisin",
+	"rakish",
+	"ramble",
+	"rammed",
+	"rancid",
+	"random",
+	"ranked",
+	"ransom",
+	"rapids",
+	"rapper",
+	"rarely",
+	"rarest",
+	"rascal",
+	"rashly",
+	"raster",
+	"rather",
+	"rating",
+	"ravage",
+	"ravine",
+	"raving",
+	"ravish",
+	"razzle",
+	"reader",
+	"really",
+	"reared",
+	"reason",
+	"reborn",
+	"recall",
+	"recent",
+	"recess",
+	"recipe",
+	"recite",
+	"reckon",
+	"recoil",
+	"record",
+	"redeem",
+	"reduce",
+	"reefer",
+	"reeled",
+	"refill",
+	"refine",
+	"reflex",
+	"reform",
+	"refund",
+	"refuse",
+	"regain",
+	"regale",
+	"regard",
+	"regent",
+	"reggae",
+	"region",
+	"reined",
+	"rejoin",
+	"relate",
+	"relief",
+	"relish",
+	"remain",
+	"remedy",
+	"remind",
+	"remote",
+	"remove",
+	"render",
+	"rennet",
+	"renown",
+	"rented",
+	"repaid",
+	"repair",
+	"repast",
+	"repeat",
+	"repent",
+	"report",
+	"rescue",
+	"resent",
+	"reside",
+	"resist",
+	"resize",
+	"resort",
+	"result",
+	"retail",
+	"retain",
+	"retina",
+	"retire",
+	"retold",
+	"retort",
+	"return",
+	"revamp",
+	"reveal",
+	"revere",
+	"revert",
+	"review",
+	"revolt",
+	"revved",
+	"reward",
+	"rewind",
+	"rhymes",
+	"rhythm",
+	"ribbed",
+	"ribbon",
+	"richly",
+	"ridden",
+	"riddle",
+	"ridged",
+	"riding",
+	"rifled",
+	"rigged",
+	"righty",
+	"rigour",
+	"ringed",
+	"rinsed",
+	"ripple",
+	"ritual",
+	"rivals",
+	"roamed",
+	"roared",
+	"robbed",
+	"robber",
+	"robins",
+	"robust",
+	"rocker",
+	"rocket",
+	"rodent",
+	"rolled",
+	"roller",
+	"romped",
+	"roofed",
+	"rookie",
+	"roomie",
+	"rooted",
+	"rosary",
+	"roster",
+	"rotate",
+	"rotten",
+	"rounds",
+	"rouser",
+	"rubber",
+	"rubble",
+	"ruckus",
+	"rudder",
+	"rudely",
+	"ruffle",
+	"rugged",
+	"ruined",
+	"rumble",
+	"rumour",
+	"rumple",
+	"runner",
+	"runway",
+	"rupees",
+	"rushed",
+	"rusted",
+	"rustic",
+	"rutted",
+	"sachet",
+	"sacked",
+	"sacred",
+	"sadden",
+	"saddle",
+	"safari",
+	"safety",
+	"sagged",
+	"sailor",
+	"salami",
+	"salary",
+	"salmon",
+	"salute",
+	"salved",
+	"sample",
+	"sandal",
+	"sanded",
+	"sanity",
+	"sapped",
+	"sashay",
+	"sateen",
+	"satire",
+	"saucer",
+	"savage",
+	"savior",
+	"savory",
+	"saying",
+	"scenic",
+	"scheme",
+	"schism",
+	"school",
+	"scolds",
+	"scones",
+	"scoops",
+	"scorch",
+	"scrawl",
+	"scream",
+	"screed",
+	"screen",
+	"screwy",
+	"scribe",
+	"script",
+	"scroll",
+	"scurry",
+	"scythe",
+	"sealed",
+	"seamed",
+	"seance",
+	"search",
+	"seared",
+	"season",
+	"seated",
+	"secede",
+	"second",
+	"secret",
+	"sector",
+	"secure",
+	"seduce",
+	"seemly",
+	"seesaw",
+	"seldom",
+	"select",
+	"senior",
+	"sensor",
+	"sequel",
+	"serene",
+	"series",
+	"sermon",
+	"serums",
+	"setter",
+	"settle",
+	"severe",
+	"sewage",
+	"sewing",
+	"sexton",
+	"shabby",
+	"shadow",
+	"shaggy",
+	"shaker",
+	"shaman",
+	"shanty",
+	"shaped",
+	"sharks",
+	"shears",
+	"sheath",
+	"sheikh",
+	"shekel",
+	"sherry",
+	"shield",
+	"shifty",
+	"shimmy",
+	"shiner",
+	"shoddy",
+	"should",
+	"shovel",
+	"shower",
+	"shrewd",
+	"shriek",
+	"shrimp",
+	"shrine",
+	"shrink",
+	"shroud",
+	"shrubs",
+	"shrugs",
+	"shucks",
+	"shunts",
+	"sicken",
+	"sickle",
+	"sickly",
+	"sierra",
+	"siesta",
+	"sifted",
+	"sighed",
+	"signal",
+	"signet",
+	"silent",
+	"silken",
+	"silver",
+	"simmer",
+	"simper",
+	"simple",
+	"sinful",
+	"singed",
+	"singer",
+	"single",
+	"sinker",
+	"sipped",
+	"sirens",
+	"sister",
+	"sitcom",
+	"sitter",
+	"sizzle",
+	"skated",
+	"skater",
+	"sketch",
+	"skewer",
+	"skiing",
+	"skimpy",
+	"skinny",
+	"skiver",
+	"slalom",
+	"slants",
+	"slated",
+	"slaved",
+	"sleazy",
+	"sledge",
+	"sleepy",
+	"sleeve",
+	"sleuth",
+	"sliced",
+	"slider",
+	"slight",
+	"slinky",
+	"sliver",
+	"slogan",
+	"sloped",
+	"sloppy",
+	"slouch",
+	"sludge",
+	"sluice",
+	"slummy",
+	"slurry",
+	"slushy",
+	"smiley",
+	"smirks",
+	"smooth",
+	"smudge",
+	"smugly",
+	"snacks",
+	"snappy",
+	"snatch",
+	"sneaky",
+	"sneeze",
+	"sniffy",
+	"snippy",
+	"snitch",
+	"snivel",
+	"snoopy",
+	"snooze",
+	"snorts",
+	"snotty",
+	"snowed",
+	"snugly",
+	"soared",
+	"sobbed",
+	"soccer",
+	"social",
+	"socket",
+	"sodden",
+	"soften",
+	"softly",
+	"soiled",
+	"solace",
+	"solder",
+	"solemn",
+	"soloed",
+	"soothe",
+	"sorbet",
+	"sordid",
+	"sorely",
+	"sorrow",
+	"sought",
+	"source",
+	"soured",
+	"sparse",
+	"speech",
+	"sphere",
+	"sphinx",
+	"spiced",
+	"spider",
+	"spigot",
+	"spinal",
+	"spiral",
+	"spirit",
+	"spited",
+	"splash",
+	"spleen",
+	"splint",
+	"spoils",
+	"spoken",
+	"sponge",
+	"spooky",
+	"sporty",
+	"spouse",
+	"sprain",
+	"sprawl",
+	"sprays",
+	"spread",
+	"spring",
+	"sprint",
+	"sprout",
+	"spruce",
+	"spurge",
+	"squall",
+	"square",
+	"squash",
+	"squawk",
+	"squeak",
+	"squeal",
+	"squint",
+	"squire",
+	"squirm",
+	"squish",
+	"stable",
+	"stains",
+	"stairs",
+	"stalks",
+	"stance",
+	"stanza",
+	"staple",
+	"starch",
+	"stared",
+	"starry",
+	"stated",
+	"statue",
+	"status",
+	"stayed",
+	"steady",
+	"steamy",
+	"steely",
+	"stench",
+	"steppe",
+	"sticky",
+	"stiffs",
+	"stifle",
+	"stigma",
+	"stinky",
+	"stitch",
+	"stodgy",
+	"stoked",
+	"stolen",
+	"stolid",
+	"stooge",
+	"stormy",
+	"strafe",
+	"strain",
+	"strait",
+	"strand",
+	"stream",
+	"street",
+	"stress",
+	"strict",
+	"stride",
+	"strife",
+	"strike",
+	"string",
+	"stripe",
+	"strive",
+	"strode",
+	"stroke",
+	"stroll",
+	"strong",
+	"strung",
+	"stucco",
+	"studio",
+	"studly",
+	"stuffy",
+	"stumpy",
+	"stupor",
+	"sturdy",
+	"styled",
+	"subdue",
+	"sublet",
+	"submit",
+	"subtle",
+	"suburb",
+	"sucker",
+	"sudden",
+	"suffer",
+	"suffix",
+	"sugary",
+	"suitor",
+	"sulked",
+	"sullen",
+	"sultan",
+	"sultry",
+	"summer",
+	"summit",
+	"summon",
+	"sundry",
+	"sunken",
+	"sunlit",
+	"sunset",
+	"supper",
+	"supple",
+	"supply",
+	"surely",
+	"surfer",
+	"surged",
+	"survey",
+	"swampy",
+	"swanky",
+	"swears",
+	"sweaty",
+	"swerve",
+	"switch",
+	"swivel",
+	"swoosh",
+	"sylvan",
+	"symbol",
+	"syntax",
+	"syrupy",
+	"system",
+	"tablet",
+	"tackle",
+	"tailor",
+	"taking",
+	"talent",
+	"talked",
+	"talker",
+	"tamale",
+	"tamper",
+	"tandem",
+	"tangle",
+	"tanker",
+	"tapped",
+	"target",
+	"tarmac",
+	"tartan",
+	"tassel",
+	"tasted",
+	"taster",
+	"tattle",
+	"tattoo",
+	"taught",
+	"taunts",
+	"tavern",
+	"teacup",
+	"teapot",
+	"teared",
+	"teased",
+	"teller",
+	"temper",
+	"temple",
+	"tenant",
+	"tender",
+	"tennis",
+	"tenure",
+	"termed",
+	"thatch",
+	"thawed",
+	"theirs",
+	"thesis",
+	"thirst",
+	"thirty",
+	"thorny",
+	"thread",
+	"threat",
+	"throat",
+	"throne",
+	"throng",
+	"thrown",
+	"thrush",
+	"thwart",
+	"ticket",
+	"tickle",
+	"tidbit",
+	"tidied",
+	"tiling",
+	"timber",
+	"timely",
+	"tinder",
+	"tingle",
+	"tinker",
+	"tinsel",
+	"tipped",
+	"tiptoe",
+	"tirade",
+	"tissue",
+	"titled",
+	"toasty",
+	"toggle",
+	"toilet",
+	"tomato",
+	"tomboy",
+	"tongue",
+	"topple",
+	"torque",
+	"tossed",
+	"totted",
+	"toucan",
+	"toupee",
+	"toured",
+	"tousle",
+	"toward",
+	"towels",
+	"tracts",
+	"trance",
+	"trauma",
+	"travel",
+	"treaty",
+	"treble",
+	"tremor",
+	"trench",
+	"trendy",
+	"tricky",
+	"trifle",
+	"trimly",
+	"triple",
+	"troops",
+	"trophy",
+	"trough",
+	"trowel",
+	"truant",
+	"truces",
+	"trudge",
+	"truest",
+	"trusty",
+	"tucked",
+	"tumble",
+	"tumult",
+	"tundra",
+	"tunnel",
+	"turkey",
+	"turtle",
+	"tuxedo",
+	"tweedy",
+	"twelve",
+	"twirly",
+	"twitch",
+	"tycoon",
+	"typist",
+	"tyrant",
+	"udders",
+	"uglier",
+	"unbolt",
+	"uncles",
+	"uncoil",
+	"uncork",
+	"undone",
+	"unfair",
+	"unfold",
+	"unglue",
+	"unhook",
+	"unique",
+	"unison",
+	"unkind",
+	"unless",
+	"unlike",
+	"unlock",
+	"unpack",
+	"unreal",
+	"unrest",
+	"unroll",
+	"unseen",
+	"untidy",
+	"untold",
+	"unveil",
+	"unwind",
+	"unwrap",
+	"upbeat",
+	"update",
+	"upkeep",
+	"uplift",
+	"uproar",
+	"upshot",
+	"uptake",
+	"upturn",
+	"urchin",
+	"urging",
+	"usable",
+	"useful",
+	"usurps",
+	"utmost",
+	"utters",
+	"vacant",
+	"vacuum",
+	"vagary",
+	"vainly",
+	"valets",
+	"valley",
+	"vanish",
+	"vanity",
+	"vapors",
+	"varied",
+	"vassal",
+	"velvet",
+	"vendor",
+	"veneer",
+	"verbal",
+	"verify",
+	"vermin",
+	"versed",
+	"vessel",
+	"vetoed",
+	"viable",
+	"vicars",
+	"victim",
+	"viewer",
+	"vigils",
+	"vilest",
+	"violet",
+	"violin",
+	"virtue",
+	"visage",
+	"vision",
+	"vistas",
+	"visual",
+	"vivace",
+	"vizier",
+	"volume",
+	"vortex",
+	"voting",
+	"vowels",
+	"voyage",
+	"waddle",
+	"waders",
+	"wafers",
+	"waffle",
+	"wagons",
+	"wailed",
+	"waited",
+	"waiter",
+	"waived",
+	"waking",
+	"walker",
+	"walled",
+	"wallet",
+	"walnut",
+	"walrus",
+	"wander",
+	"waning",
+	"wanton",
+	"warble",
+	"warden",
+	"warmer",
+	"warmly",
+	"warned",
+	"warped",
+	"washed",
+	"washer",
+	"wasted",
+	"wastes",
+	"waters",
+	"wavers",
+	"waxing",
+	"weakly",
+	"wealth",
+	"weaned",
+	"weapon",
+	"wearer",
+	"weasel",
+	"webbed",
+	"wedded",
+	"wedged",
+	"weeded",
+	"weekly",
+	"weight",
+	"weirdo",
+	"welder",
+	"whacky",
+	"wheels",
+	"wheeze",
+	"whence",
+	"whiffs",
+	"whimsy",
+	"whiner",
+	"whirls",
+	"whisks",
+	"whisky",
+	"wicked",
+	"wicker",
+	"widely",
+	"widest",
+	"wields",
+	"wiggle",
+	"wigwam",
+	"willow",
+	"wilted",
+	"wimple",
+	"winced",
+	"winded",
+	"window",
+	"winery",
+	"winged",
+	"winked",
+	"winner",
+	"winnow",
+	"winter",
+	"wintry",
+	"wiping",
+	"wiring",
+	"wisdom",
+	"wisely",
+	"wished",
+	"withal",
+	"wither",
+	"within",
+	"witted",
+	"wizard",
+	"wobble",
+	"wobbly",
+	"woeful",
+	"wolves",
+	"wombat",
+	"wonder",
+	"wooden",
+	"woolen",
+	"worded",
+	"worker",
+	"worlds",
+	"wormed",
+	"worsen",
+	"worthy",
+	"wraith",
+	"wreath",
+	"wrench",
+	"wretch",
+	"wrists",
+	"writer",
+	"writhe",
+	"yachts",
+	"yanked",
+	"yarrow",
+	"yearly",
+	"yeasty",
+	"yelled",
+	"yellow",
+	"yelped",
+	"yields",
+	"yodels",
+	"yogurt",
+	"yonder",
+	"zapped",
+	"zealot",
+	"zenith",
+	"zigzag",
+	"zinnia",
+	"zipped",
+	"zipper",
+	"zodiac",
+	"zombie",
+	"zoning",
+	"zoomed",
+}
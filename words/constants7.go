@@ -0,0 +1,3839 @@
+package words
+
+var words7 = []string{
+	"chamber",
+	"inquiry",
+	"initial",
+	"musical",
+	"holding",
+	"virtual",
+	"western",
+	"veteran",
+	"assault",
+	"contact",
+	"servant",
+	"develop",
+	"mention",
+	"special",
+	"neutral",
+	"support",
+	"concept",
+	"vehicle",
+	"failing",
+	"silence",
+	"smoking",
+	"partial",
+	"touched",
+	"penalty",
+	"typical",
+	"strange",
+	"central",
+	"sitting",
+	"promise",
+	"primary",
+	"grocery",
+	"parking",
+	"reserve",
+	"killing",
+	"meeting",
+	"control",
+	"factory",
+	"filling",
+	"exactly",
+	"feeling",
+	"classic",
+	"warrior",
+	"monitor",
+	"builder",
+	"advised",
+	"circuit",
+	"present",
+	"teacher",
+	"remains",
+	"airport",
+	"weekend",
+	"complex",
+	"alleged",
+	"painter",
+	"unknown",
+	"license",
+	"suspect",
+	"fortune",
+	"landing",
+	"written",
+	"website",
+	"meaning",
+	"patient",
+	"missing",
+	"whether",
+	"venture",
+	"trouble",
+	"intense",
+	"adverse",
+	"message",
+	"himself",
+	"reflect",
+	"finding",
+	"require",
+	"already",
+	"publish",
+	"premium",
+	"sustain",
+	"loyalty",
+	"unusual",
+	"contain",
+	"pacific",
+	"correct",
+	"turning",
+	"gesture",
+	"outlook",
+	"deliver",
+	"upgrade",
+	"careful",
+	"pattern",
+	"writing",
+	"chicken",
+	"crystal",
+	"holiday",
+	"advance",
+	"through",
+	"network",
+	"kitchen",
+	"element",
+	"against",
+	"conduct",
+	"realize",
+	"pending",
+	"winning",
+	"persist",
+	"culture",
+	"similar",
+	"married",
+	"tension",
+	"connect",
+	"radical",
+	"display",
+	"caution",
+	"driving",
+	"combine",
+	"process",
+	"engaged",
+	"imagine",
+	"prepare",
+	"somehow",
+	"chronic",
+	"service",
+	"analyst",
+	"fitness",
+	"wealthy",
+	"running",
+	"further",
+	"absence",
+	"machine",
+	"counter",
+	"accused",
+	"destroy",
+	"feature",
+	"respond",
+	"economy",
+	"divided",
+	"install",
+	"current",
+	"reality",
+	"alcohol",
+	"habitat",
+	"context",
+	"distant",
+	"violent",
+	"replace",
+	"passage",
+	"largely",
+	"pension",
+	"fashion",
+	"passive",
+	"federal",
+	"brought",
+	"husband",
+	"premier",
+	"removal",
+	"perform",
+	"problem",
+	"account",
+	"achieve",
+	"recover",
+	"expense",
+	"diverse",
+	"reverse",
+	"regular",
+	"decline",
+	"manager",
+	"supreme",
+	"session",
+	"justify",
+	"plastic",
+	"enhance",
+	"bearing",
+	"improve",
+	"surface",
+	"minimal",
+	"because",
+	"genetic",
+	"interim",
+	"pleased",
+	"consent",
+	"anxious",
+	"proceed",
+	"morning",
+	"promote",
+	"besides",
+	"greater",
+	"instant",
+	"forever",
+	"confirm",
+	"section",
+	"failure",
+	"anxiety",
+	"express",
+	"however",
+	"faculty",
+	"default",
+	"officer",
+	"kingdom",
+	"minimum",
+	"waiting",
+	"million",
+	"shelter",
+	"blanket",
+	"pumpkin",
+	"railway",
+	"restore",
+	"reading",
+	"routine",
+	"shortly",
+	"command",
+	"maximum",
+	"journal",
+	"version",
+	"comfort",
+	"evident",
+	"mystery",
+	"journey",
+	"project",
+	"decided",
+	"certain",
+	"witness",
+	"warning",
+	"address",
+	"welfare",
+	"studied",
+	"program",
+	"readily",
+	"nuclear",
+	"caliber",
+	"overall",
+	"payment",
+	"consist",
+	"history",
+	"capital",
+	"herself",
+	"survive",
+	"subject",
+	"predict",
+	"painted",
+	"highway",
+	"examine",
+	"picture",
+	"explain",
+	"segment",
+	"desktop",
+	"handful",
+	"release",
+	"disease",
+	"cabinet",
+	"quality",
+	"company",
+	"portion",
+	"storage",
+	"perhaps",
+	"devoted",
+	"diamond",
+	"visible",
+	"essence",
+	"revenue",
+	"charity",
+	"viewing",
+	"summary",
+	"purpose",
+	"climate",
+	"succeed",
+	"discuss",
+	"thereby",
+	"telling",
+	"towards",
+	"beneath",
+	"exclude",
+	"learned",
+	"lasting",
+	"stretch",
+	"liberal",
+	"soldier",
+	"captain",
+	"related",
+	"partner",
+	"pushing",
+	"despite",
+	"charter",
+	"genuine",
+	"various",
+	"barrier",
+	"airline",
+	"founder",
+	"bombing",
+	"whereas",
+	"pointed",
+	"offense",
+	"ability",
+	"terrain",
+	"arrival",
+	"respect",
+	"burning",
+	"closing",
+	"insight",
+	"contest",
+	"balance",
+	"concert",
+	"receive",
+	"private",
+	"leading",
+	"brother",
+	"heading",
+	"heavily",
+	"profile",
+	"therapy",
+	"thought",
+	"removed",
+	"article",
+	"medical",
+	"capture",
+	"explore",
+	"victory",
+	"protest",
+	"gallery",
+	"mistake",
+	"serious",
+	"chapter",
+	"academy",
+	"dynamic",
+	"digital",
+	"compete",
+	"outside",
+	"leisure",
+	"acquire",
+	"dealing",
+	"precise",
+	"without",
+	"comment",
+	"believe",
+	"scholar",
+	"cutting",
+	"getting",
+	"formula",
+	"housing",
+	"surgery",
+	"privacy",
+	"imaging",
+	"century",
+	"drawing",
+	"neither",
+	"helpful",
+	"several",
+	"collect",
+	"skilled",
+	"request",
+	"harmony",
+	"urgency",
+	"density",
+	"carrier",
+	"seventh",
+	"council",
+	"suppose",
+	"limited",
+	"calling",
+	"passion",
+	"college",
+	"foreign",
+	"justice",
+	"adviser",
+	"finance",
+	"rolling",
+	"growing",
+	"quarter",
+	"eastern",
+	"instead",
+	"success",
+	"utility",
+	"popular",
+	"excited",
+	"provide",
+	"variety",
+	"backing",
+	"prevent",
+	"protect",
+	"knowing",
+	"dispute",
+	"billion",
+	"walking",
+	"jointly",
+	"optical",
+	"suggest",
+	"package",
+	"fishing",
+	"propose",
+	"fiction",
+	"student",
+	"opening",
+	"uniform",
+	"retired",
+	"beating",
+	"printer",
+	"another",
+	"hunting",
+	"library",
+	"working",
+	"sharing",
+	"resolve",
+	"clothes",
+	"traffic",
+	"measure",
+	"totally",
+	"willing",
+	"passing",
+	"edition",
+	"integer",
+	"receipt",
+	"fifteen",
+	"listing",
+	"mineral",
+	"sponsor",
+	"operate",
+	"bedroom",
+	"deficit",
+	"science",
+	"protein",
+	"theatre",
+	"setting",
+	"deposit",
+	"evening",
+	"forward",
+	"involve",
+	"wedding",
+	"keeping",
+	"exhibit",
+	"satisfy",
+	"illness",
+	"mission",
+	"produce",
+	"logical",
+	"content",
+	"payable",
+	"village",
+	"numeral",
+	"average",
+	"qualify",
+	"natural",
+	"biology",
+	"general",
+	"concern",
+	"pioneer",
+	"tonight",
+	"organic",
+	"booking",
+	"compare",
+	"product",
+	"society",
+	"nowhere",
+	"example",
+	"ongoing",
+	"capable",
+	"monthly",
+	"channel",
+	"wanting",
+	"elderly",
+	"ceiling",
+	"surplus",
+	"nothing",
+	"anybody",
+	"country",
+	"arrange",
+	"hearing",
+	"outdoor",
+	"opinion",
+	"auction",
+	"welcome",
+	"percent",
+	"perfect",
+	"crucial",
+	"caption",
+	"obvious",
+	"station",
+	"attract",
+	"illegal",
+	"nervous",
+	"between",
+	"defence",
+	"banking",
+	"hundred",
+	"include",
+	"benefit",
+	"ancient",
+	"convert",
+	"massive",
+	"useless",
+	"attempt",
+	"outcome",
+	"freedom",
+	"binding",
+	"mixture",
+	"speaker",
+	"battery",
+	"applied",
+	"poverty",
+	"healthy",
+	"checked",
+	"weather",
+	"extreme",
+}
+
+var valid7 = []string{
+	"abandon",
+	"abdomen",
+	"abiding",
+	"ability",
+	"abolish",
+	"abreast",
+	"abridge",
+	"absence",
+	"absolve",
+	"abstain",
+	"abusive",
+	"academy",
+	"acclaim",
+	"account",
+	"accrual",
+	"accrued",
+	"accused",
+	"acetone",
+	"achieve",
+	"acidity",
+	"acolyte",
+	"acquire",
+	"acrobat",
+	"actress",
+	"adamant",
+	"adapted",
+	"adapter",
+	"addicts",
+	"address",
+	"adjourn",
+	"admiral",
+	"adopted",
+	"adorned",
+	"advance",
+	"adverse",
+	"advised",
+	"adviser",
+	"aerobic",
+	"affable",
+	"afflict",
+	"affront",
+	"against",
+	"ailment",
+	"airless",
+	"airlift",
+	"airline",
+	"airport",
+	"airship",
+	"alchemy",
+	"alcohol",
+	"alfalfa",
+	"algebra",
+	"aliases",
+	"alimony",
+	"alleged",
+	"allergy",
+	"allowed",
+	"almanac",
+	"already",
+	"alumnus",
+	"amateur",
+	"amazing",
+	"ambient",
+	"amnesia",
+	"amplify",
+	"anagram",
+	"analogy",
+	"analyst",
+	"anatomy",
+	"anchors",
+	"ancient",
+	"android",
+	"angrier",
+	"angrily",
+	"anguish",
+	"animate",
+	"annoyed",
+	"anoints",
+	"another",
+	"antenna",
+	"anthems",
+	"antique",
+	"antlers",
+	"anxiety",
+	"anxious",
+	"anybody",
+	"apology",
+	"apostle",
+	"apparel",
+	"appease",
+	"applaud",
+	"applied",
+	"apricot",
+	"aquatic",
+	"arbiter",
+	"archery",
+	"archive",
+	"arduous",
+	"armband",
+	"armored",
+	"arrange",
+	"arrival",
+	"arsenal",
+	"article",
+	"artisan",
+	"artwork",
+	"ascetic",
+	"ashamed",
+	"asphalt",
+	"aspirin",
+	"assault",
+	"assured",
+	"astound",
+	"astride",
+	"atheist",
+	"athlete",
+	"atrophy",
+	"attempt",
+	"attract",
+	"attuned",
+	"auction",
+	"auditor",
+	"augment",
+	"austere",
+	"avarice",
+	"avenged",
+	"average",
+	"avocado",
+	"awaited",
+	"awfully",
+	"awkward",
+	"babysit",
+	"bacilli",
+	"backing",
+	"backlog",
+	"badland",
+	"baggage",
+	"bagpipe",
+	"balance",
+	"balcony",
+	"balding",
+	"baleful",
+	"ballads",
+	"balloon",
+	"bandage",
+	"bandana",
+	"banking",
+	"banquet",
+	"baptism",
+	"barbell",
+	"bargain",
+	"baronet",
+	"barrack",
+	"barrage",
+	"barrier",
+	"basking",
+	"bassoon",
+	"bastion",
+	"batches",
+	"bathtub",
+	"battery",
+	"battled",
+	"beaming",
+	"bearded",
+	"bearing",
+	"beastly",
+	"beating",
+	"because",
+	"beckons",
+	"bedding",
+	"bedevil",
+	"bedrock",
+	"bedroom",
+	"beehive",
+	"begonia",
+	"beguile",
+	"belated",
+	"believe",
+	"bellboy",
+	"beloved",
+	"bemused",
+	"benches",
+	"beneath",
+	"benefit",
+	"bequest",
+	"berserk",
+	"beseech",
+	"besides",
+	"betting",
+	"between",
+	"bewitch",
+	"biggest",
+	"bigotry",
+	"bikinis",
+	"billion",
+	"binders",
+	"binding",
+	"biology",
+	"biscuit",
+	"bittern",
+	"bizarre",
+	"blanket",
+	"blankly",
+	"blaring",
+	"blessed",
+	"blister",
+	"bloated",
+	"blossom",
+	"blotchy",
+	"blowout",
+	"blubber",
+	"bluffed",
+	"blunder",
+	"blurred",
+	"blushed",
+	"bluster",
+	"boarded",
+	"boaster",
+	"boatman",
+	"bobbing",
+	"bobsled",
+	"bodices",
+	"boggled",
+	"boiling",
+	"bollard",
+	"bombard",
+	"bombing",
+	"bonanza",
+	"bondage",
+	"bonfire",
+	"bookend",
+	"booking",
+	"booklet",
+	"boorish",
+	"booster",
+	"bootleg",
+	"borders",
+	"boredom",
+	"bottled",
+	"boulder",
+	"bouncer",
+	"bouquet",
+	"bowling",
+	"boxcars",
+	"boycott",
+	"bracket",
+	"braided",
+	"braille",
+	"bramble",
+	"bravado",
+	"bravely",
+	"bravery",
+	"brawled",
+	"breaded",
+	"breaker",
+	"breezes",
+	"brewery",
+	"bribery",
+	"brigade",
+	"brimful",
+	"brisket",
+	"bristle",
+	"brittle",
+	"broaden",
+	"broadly",
+	"brocade",
+	"broiled",
+	"broiler",
+	"bromide",
+	"bronzed",
+	"brooded",
+	"brother",
+	"brought",
+	"brownie",
+	"bruised",
+	"brushed",
+	"brusque",
+	"buckled",
+	"buffalo",
+	"buffoon",
+	"bugling",
+	"builder",
+	"bulldog",
+	"bullion",
+	"bullpen",
+	"bulrush",
+	"bumpkin",
+	"bundled",
+	"bunting",
+	"buoyant",
+	"burdens",
+	"burglar",
+	"burning",
+	"burnish",
+	"burrito",
+	"burrows",
+	"bursary",
+	"busboys",
+	"bustard",
+	"butcher",
+	"buttery",
+	"buzzard",
+	"bylines",
+	"cabbage",
+	"cabinet",
+	"cadaver",
+	"cadence",
+	"cajoled",
+	"calcium",
+	"caldron",
+	"caliber",
+	"calling",
+	"callous",
+	"calmest",
+	"calorie",
+	"calypso",
+	"cambric",
+	"camelia",
+	"cameras",
+	"campers",
+	"candied",
+	"candour",
+	"canteen",
+	"canvass",
+	"capable",
+	"capital",
+	"captain",
+	"caption",
+	"capture",
+	"caramel",
+	"caravan",
+	"cardiac",
+	"careers",
+	"careful",
+	"carfare",
+	"cargoes",
+	"carious",
+	"carnage",
+	"carouse",
+	"carport",
+	"carrier",
+	"cartoon",
+	"cascade",
+	"cashier",
+	"casings",
+	"cassava",
+	"casting",
+	"castoff",
+	"catalog",
+	"catcall",
+	"catfish",
+	"cathode",
+	"catkins",
+	"cattail",
+	"caustic",
+	"caution",
+	"cavalry",
+	"caverns",
+	"cayenne",
+	"ceiling",
+	"central",
+	"century",
+	"ceramic",
+	"cereals",
+	"certain",
+	"certify",
+	"chaffed",
+	"chalice",
+	"chamber",
+	"chamois",
+	"channel",
+	"chapped",
+	"chapter",
+	"charade",
+	"chariot",
+	"charity",
+	"charmed",
+	"charter",
+	"chasing",
+	"chassis",
+	"chateau",
+	"chatted",
+	"chattel",
+	"cheaper",
+	"cheater",
+	"checked",
+	"checker",
+	"cheddar",
+	"cheerio",
+	"cheetah",
+	"chemist",
+	"cherubs",
+	"chervil",
+	"chewing",
+	"chicken",
+	"chicory",
+	"chiding",
+	"chimney",
+	"chinked",
+	"chipped",
+	"chirped",
+	"chisels",
+	"chorale",
+	"chortle",
+	"chowder",
+	"chronic",
+	"chuckle",
+	"chugged",
+	"chunked",
+	"churned",
+	"cigaret",
+	"cinders",
+	"circled",
+	"circuit",
+	"cistern",
+	"citadel",
+	"citizen",
+	"civilly",
+	"clamber",
+	"clamour",
+	"clapper",
+	"clarify",
+	"clarion",
+	"classic",
+	"clatter",
+	"cleanse",
+	"cleaver",
+	"clement",
+	"climate",
+	"climbed",
+	"clinked",
+	"clipper",
+	"clobber",
+	"cloning",
+	"closely",
+	"closest",
+	"closing",
+	"clothed",
+	"clothes",
+	"clotted",
+	"cloture",
+	"clubbed",
+	"clucked",
+	"clumped",
+	"cluster",
+	"clutter",
+	"coastal",
+	"coaxial",
+	"cobbler",
+	"cockpit",
+	"coconut",
+	"codfish",
+	"coexist",
+	"coffers",
+	"cogency",
+	"cohabit",
+	"coiffed",
+	"collage",
+	"collard",
+	"collect",
+	"college",
+	"collude",
+	"colonel",
+	"colossi",
+	"combine",
+	"combust",
+	"comedic",
+	"comfort",
+	"command",
+	"comment",
+	"company",
+	"compare",
+	"compass",
+	"compete",
+	"complex",
+	"compost",
+	"compute",
+	"comrade",
+	"concave",
+	"conceit",
+	"concept",
+	"concern",
+	"concert",
+	"concise",
+	"condone",
+	"conduct",
+	"conduit",
+	"confess",
+	"confide",
+	"confirm",
+	"conform",
+	"congeal",
+	"conical",
+	"conjure",
+	"connect",
+	"consent",
+	"consist",
+	"consort",
+	"contact",
+	"contain",
+	"content",
+	"contest",
+	"context",
+	"contour",
+	"control",
+	"convene",
+	"convert",
+	"convict",
+	"cookery",
+	"copious",
+	"copycat",
+	"cordial",
+	"corncob",
+	"cornice",
+	"correct",
+	"corrode",
+	"corsage",
+	"costume",
+	"cottage",
+	"couched",
+	"cougars",
+	"council",
+	"counter",
+	"country",
+	"courage",
+	"courier",
+	"courtly",
+	"cowbell",
+	"cowhide",
+	"coxcomb",
+	"crackle",
+	"crafted",
+	"cramped",
+	"cranial",
+	"cranked",
+	"crashed",
+	"crasser",
+	"craters",
+	"craving",
+	"crayons",
+	"crazily",
+	"creamer",
+	"creased",
+	"creator",
+	"credits",
+	"creeper",
+	"crevice",
+	"cricket",
+	"crimson",
+	"crinkle",
+	"cripple",
+	"crisply",
+	"critter",
+	"croquet",
+	"crowbar",
+	"crowded",
+	"crucial",
+	"crudely",
+	"cruelty",
+	"cruiser",
+	"crumble",
+	"crumbly",
+	"crumpet",
+	"crumple",
+	"crusade",
+	"crushed",
+	"crybaby",
+	"cryptic",
+	"crystal",
+	"cubicle",
+	"cuckold",
+	"cuckoos",
+	"cudgels",
+	"cuisine",
+	"culprit",
+	"culture",
+	"cunning",
+	"cupcake",
+	"curator",
+	"curdled",
+	"current",
+	"cursive",
+	"curtail",
+	"curtain",
+	"cushion",
+	"custard",
+	"customs",
+	"cutback",
+	"cutlass",
+	"cutting",
+	"cyclist",
+	"cynical",
+	"cypress",
+	"dabbled",
+	"dallied",
+	"damning",
+	"dampest",
+	"damsels",
+	"dancing",
+	"dappled",
+	"darling",
+	"dashing",
+	"daunted",
+	"dauphin",
+	"dawdled",
+	"daytime",
+	"dazzled",
+	"deadpan",
+	"dealers",
+	"dealing",
+	"dearest",
+	"debacle",
+	"debrief",
+	"decency",
+	"decided",
+	"decimal",
+	"declaim",
+	"decline",
+	"decorum",
+	"decreed",
+	"decried",
+	"deepest",
+	"default",
+	"defence",
+	"defiant",
+	"deficit",
+	"deflate",
+	"defrost",
+	"defunct",
+	"degrade",
+	"deistic",
+	"delight",
+	"deliver",
+	"deluded",
+	"deluged",
+	"demerit",
+	"denizen",
+	"density",
+	"dentist",
+	"deplete",
+	"deplore",
+	"deposed",
+	"deposit",
+	"depress",
+	"deprive",
+	"derange",
+	"derided",
+	"dervish",
+	"descant",
+	"descend",
+	"deserve",
+	"desired",
+	"desktop",
+	"despair",
+	"despise",
+	"despite",
+	"despond",
+	"dessert",
+	"destiny",
+	"destroy",
+	"detente",
+	"detract",
+	"develop",
+	"devilry",
+	"devious",
+	"devolve",
+	"devoted",
+	"devotee",
+	"dewdrop",
+	"diagram",
+	"dialect",
+	"diamond",
+	"diaries",
+	"dictate",
+	"diffuse",
+	"digital",
+	"digress",
+	"dilemma",
+	"diluted",
+	"dimness",
+	"dimpled",
+	"dinette",
+	"diocese",
+	"diploma",
+	"direful",
+	"dirtied",
+	"disable",
+	"disavow",
+	"disband",
+	"discard",
+	"discern",
+	"discord",
+	"discuss",
+	"disdain",
+	"disease",
+	"disgust",
+	"dislike",
+	"dismiss",
+	"disobey",
+	"display",
+	"disport",
+	"dispute",
+	"disrobe",
+	"dissect",
+	"distant",
+	"distill",
+	"distort",
+	"disturb",
+	"dittoes",
+	"diverge",
+	"diverse",
+	"divided",
+	"divider",
+	"divvied",
+	"dizzily",
+	"doctors",
+	"doggone",
+	"doleful",
+	"dolphin",
+	"dormant",
+	"dormice",
+	"doughty",
+	"dowager",
+	"drafted",
+	"dragnet",
+	"drapery",
+	"drastic",
+	"draught",
+	"drawing",
+	"dreaded",
+	"dredged",
+	"dresser",
+	"dribble",
+	"driblet",
+	"drifter",
+	"drinker",
+	"dripped",
+	"driving",
+	"drizzle",
+	"droplet",
+	"drought",
+	"drugged",
+	"drummer",
+	"drunken",
+	"dryness",
+	"dualism",
+	"dubious",
+	"duchess",
+	"ducking",
+	"ductile",
+	"dueling",
+	"dukedom",
+	"dullard",
+	"dumbest",
+	"dungeon",
+	"durable",
+	"dwarfed",
+	"dwindle",
+	"dynamic",
+	"dynasty",
+	"eagerly",
+	"earache",
+	"earldom",
+	"earmark",
+	"earnest",
+	"earring",
+	"earshot",
+	"earthen",
+	"earthly",
+	"easiest",
+	"eastern",
+	"echelon",
+	"eclipse",
+	"ecology",
+	"economy",
+	"ecstasy",
+	"edifice",
+	"editing",
+	"edition",
+	"educate",
+	"effects",
+	"egghead",
+	"egotism",
+	"elapsed",
+	"elastic",
+	"elation",
+	"elderly",
+	"elegant",
+	"elegiac",
+	"element",
+	"elevate",
+	"elision",
+	"elitist",
+	"elusive",
+	"emblems",
+	"embrace",
+	"embroil",
+	"emerald",
+	"eminent",
+	"emitted",
+	"emotion",
+	"empathy",
+	"emperor",
+	"empower",
+	"emptily",
+	"emulate",
+	"enamels",
+	"enchant",
+	"enclave",
+	"encrust",
+	"endemic",
+	"endless",
+	"endorse",
+	"endowed",
+	"enfeoff",
+	"enforce",
+	"engaged",
+	"engrave",
+	"engross",
+	"engulfs",
+	"enhance",
+	"enigmas",
+	"enjoyed",
+	"enlarge",
+	"enliven",
+	"ennoble",
+	"enquire",
+	"enraged",
+	"enslave",
+	"ensnare",
+	"entente",
+	"enthral",
+	"entitle",
+	"entrant",
+	"entreat",
+	"entropy",
+	"entrust",
+	"envelop",
+	"envious",
+	"epicure",
+	"episode",
+	"epistle",
+	"epitaph",
+	"epithet",
+	"epitome",
+	"equable",
+	"equinox",
+	"erasing",
+	"erosion",
+	"errands",
+	"erratic",
+	"erudite",
+	"escaped",
+	"eschews",
+	"espouse",
+	"esquire",
+	"essence",
+	"estuary",
+	"eternal",
+	"ethical",
+	"eunuchs",
+	"euphony",
+	"evasion",
+	"evasive",
+	"evening",
+	"evident",
+	"exactly",
+	"exalted",
+	"examine",
+	"example",
+	"excited",
+	"exclaim",
+	"exclude",
+	"excrete",
+	"execute",
+	"exhaust",
+	"exhibit",
+	"exhumed",
+	"expanse",
+	"expense",
+	"expired",
+	"explain",
+	"explode",
+	"exploit",
+	"explore",
+	"exposed",
+	"expound",
+	"express",
+	"expunge",
+	"extinct",
+	"extract",
+	"extreme",
+	"exuding",
+	"eyebrow",
+	"eyelash",
+	"eyesore",
+	"fabrics",
+	"faceted",
+	"factory",
+	"factual",
+	"faculty",
+	"failing",
+	"failure",
+	"faintly",
+	"fairway",
+	"falcons",
+	"fallacy",
+	"fanatic",
+	"fancied",
+	"fanfare",
+	"fantasy",
+	"farming",
+	"farther",
+	"fascism",
+	"fashion",
+	"fasting",
+	"fatally",
+	"fathead",
+	"fatigue",
+	"faucets",
+	"favored",
+	"fearful",
+	"feather",
+	"feature",
+	"federal",
+	"feeling",
+	"feigned",
+	"ferment",
+	"fernery",
+	"ferries",
+	"fervent",
+	"festive",
+	"fetched",
+	"fetlock",
+	"fibrous",
+	"fiction",
+	"fiddler",
+	"fidgety",
+	"fielder",
+	"fiercer",
+	"fifteen",
+	"fighter",
+	"figment",
+	"figured",
+	"filbert",
+	"filings",
+	"filling",
+	"finally",
+	"finance",
+	"finding",
+	"finesse",
+	"fingers",
+	"firearm",
+	"firefly",
+	"fishing",
+	"fishnet",
+	"fissure",
+	"fitness",
+	"fixture",
+	"flailed",
+	"flaming",
+	"flannel",
+	"flapped",
+	"flashed",
+	"flatten",
+	"flatter",
+	"flaunts",
+	"flavors",
+	"flexing",
+	"flicker",
+	"flighty",
+	"flipper",
+	"flitted",
+	"floated",
+	"flooded",
+	"floored",
+	"florist",
+	"flotsam",
+	"flounce",
+	"flowery",
+	"flowing",
+	"fluency",
+	"fluffed",
+	"flushed",
+	"fluster",
+	"flutist",
+	"flutter",
+	"focused",
+	"foggily",
+	"foghorn",
+	"foliage",
+	"fondant",
+	"fondled",
+	"foolish",
+	"footage",
+	"footman",
+	"footpad",
+	"forbade",
+	"forbear",
+	"foreign",
+	"foreman",
+	"forever",
+	"forgave",
+	"forlorn",
+	"formula",
+	"fortify",
+	"fortune",
+	"forward",
+	"fossils",
+	"fouling",
+	"founded",
+	"founder",
+	"foundry",
+	"fourths",
+	"foxhole",
+	"fragile",
+	"frailty",
+	"framing",
+	"frankly",
+	"frantic",
+	"fraught",
+	"frazzle",
+	"freckle",
+	"freebie",
+	"freedom",
+	"freeway",
+	"freezer",
+	"freight",
+	"freshen",
+	"fretful",
+	"friable",
+	"frigate",
+	"fritter",
+	"frizzle",
+	"frogman",
+	"frolics",
+	"frontal",
+	"frosted",
+	"frothed",
+	"frowned",
+	"fuchsia",
+	"fulcrum",
+	"fullest",
+	"fulsome",
+	"fumbled",
+	"funeral",
+	"fungous",
+	"funnier",
+	"furious",
+	"furlong",
+	"furnace",
+	"furnish",
+	"furrows",
+	"further",
+	"fusible",
+	"fussily",
+	"fustian",
+	"gabbled",
+	"gainful",
+	"gallant",
+	"galleon",
+	"gallery",
+	"galleys",
+	"gallons",
+	"gallows",
+	"garbage",
+	"garland",
+	"garment",
+	"garnish",
+	"gaseous",
+	"gastric",
+	"gateway",
+	"gauntly",
+	"gavotte",
+	"gazelle",
+	"gearbox",
+	"gelatin",
+	"general",
+	"generic",
+	"genetic",
+	"genteel",
+	"gentian",
+	"gentile",
+	"genuine",
+	"geology",
+	"gestate",
+	"gesture",
+	"getaway",
+	"getting",
+	"ghastly",
+	"gherkin",
+	"ghostly",
+	"giggled",
+	"gimmick",
+	"ginseng",
+	"giraffe",
+	"girders",
+	"girlish",
+	"glacial",
+	"gladden",
+	"glamour",
+	"glaring",
+	"glazier",
+	"gleaned",
+	"glimmer",
+	"glimpse",
+	"glisten",
+	"glitter",
+	"gloated",
+	"glorify",
+	"glowing",
+	"glucose",
+	"glutton",
+	"gnarled",
+	"gnawing",
+	"goddess",
+	"godlike",
+	"godsend",
+	"goggles",
+	"gondola",
+	"goodbye",
+	"gorilla",
+	"gosling",
+	"gossipy",
+	"gourmet",
+	"governs",
+	"grabbed",
+	"gracing",
+	"gradual",
+	"grafted",
+	"grammar",
+	"granary",
+	"grandma",
+	"granite",
+	"granola",
+	"granule",
+	"grapple",
+	"grasped",
+	"gratify",
+	"grating",
+	"gravity",
+	"greater",
+	"greatly",
+	"greener",
+	"greeted",
+	"gremlin",
+	"greyish",
+	"griddle",
+	"grieved",
+	"griffin",
+	"grimace",
+	"grinder",
+	"gripped",
+	"gristle",
+	"grizzly",
+	"grocery",
+	"grommet",
+	"grossly",
+	"grouchy",
+	"groupie",
+	"growing",
+	"growled",
+	"grownup",
+	"gruffly",
+	"grumble",
+	"grunted",
+	"guarded",
+	"guessed",
+	"guiding",
+	"guilder",
+	"guitars",
+	"gumdrop",
+	"gunfire",
+	"gunshot",
+	"gushing",
+	"gutless",
+	"guzzled",
+	"gymnast",
+	"habitat",
+	"hacksaw",
+	"haddock",
+	"haggard",
+	"haircut",
+	"halibut",
+	"hallway",
+	"halting",
+	"hammock",
+	"hamster",
+	"handbag",
+	"handcar",
+	"handful",
+	"handily",
+	"hangars",
+	"hapless",
+	"happily",
+	"harbour",
+	"hardhat",
+	"harmful",
+	"harmony",
+	"harness",
+	"harpoon",
+	"harried",
+	"harshly",
+	"harvest",
+	"hashish",
+	"hatched",
+	"hatchet",
+	"haughty",
+	"haulage",
+	"haunted",
+	"haywire",
+	"hazards",
+	"heading",
+	"headway",
+	"healthy",
+	"hearing",
+	"hearsay",
+	"heathen",
+	"heather",
+	"heating",
+	"heavens",
+	"heavily",
+	"hectare",
+	"hedonic",
+	"heedful",
+	"heftily",
+	"heinous",
+	"heiress",
+	"helical",
+	"helpful",
+	"hemlock",
+	"hennaed",
+	"heralds",
+	"herbage",
+	"heretic",
+	"heroine",
+	"heroism",
+	"herring",
+	"herself",
+	"hexagon",
+	"hibachi",
+	"hiccups",
+	"hideous",
+	"highway",
+	"hilltop",
+	"himself",
+	"hinting",
+	"hipster",
+	"hirsute",
+	"history",
+	"hitched",
+	"hoarded",
+	"hoarder",
+	"hobbled",
+	"hobnail",
+	"holding",
+	"holiday",
+	"hollows",
+	"holster",
+	"homonym",
+	"honesty",
+	"hoodlum",
+	"hopeful",
+	"horizon",
+	"hormone",
+	"horrify",
+	"hosanna",
+	"hostage",
+	"hostile",
+	"hotcake",
+	"hothead",
+	"hotline",
+	"hottest",
+	"housing",
+	"however",
+	"howling",
+	"huddled",
+	"humanly",
+	"humbled",
+	"humdrum",
+	"humerus",
+	"humidly",
+	"hunched",
+	"hundred",
+	"hunting",
+	"hurdled",
+	"hurtful",
+	"husband",
+	"hushing",
+	"huskily",
+	"hustler",
+	"hydrant",
+	"hygiene",
+	"hymnals",
+	"hyphens",
+	"iceberg",
+	"idiotic",
+	"idolize",
+	"igneous",
+	"ignoble",
+	"illegal",
+	"illicit",
+	"illness",
+	"imagery",
+	"imagine",
+	"imaging",
+	"imitate",
+	"immense",
+	"immerse",
+	"impasse",
+	"impeach",
+	"imperil",
+	"impetus",
+	"impinge",
+	"impious",
+	"implant",
+	"implied",
+	"impound",
+	"impress",
+	"imprint",
+	"improve",
+	"impulse",
+	"inanely",
+	"incense",
+	"incisor",
+	"incline",
+	"include",
+	"inertia",
+	"infancy",
+	"inferno",
+	"infidel",
+	"inflate",
+	"inflict",
+	"ingenue",
+	"ingrown",
+	"inhabit",
+	"inherit",
+	"inhibit",
+	"inhuman",
+	"initial",
+	"injured",
+	"inkling",
+	"inkwell",
+	"inmates",
+	"innards",
+	"inquest",
+	"inquire",
+	"inquiry",
+	"inshore",
+	"insider",
+	"insight",
+	"insipid",
+	"inspect",
+	"install",
+	"instant",
+	"instead",
+	"insulin",
+	"insured",
+	"integer",
+	"intense",
+	"interim",
+	"intrude",
+	"invader",
+	"inverse",
+	"invoice",
+	"involve",
+	"inwards",
+	"irately",
+	"irksome",
+	"ironing",
+	"islands",
+	"isotope",
+	"issuing",
+	"italics",
+	"itching",
+	"jackals",
+	"jackass",
+	"jackpot",
+	"jaguars",
+	"janitor",
+	"jasmine",
+	"javelin",
+	"jawbone",
+	"jealous",
+	"jellied",
+	"jerkily",
+	"jetties",
+	"jewelry",
+	"jingled",
+	"jittery",
+	"jobless",
+	"jockeys",
+	"jocular",
+	"joinery",
+	"jointed",
+	"jointly",
+	"jollity",
+	"jonquil",
+	"journal",
+	"journey",
+	"jousted",
+	"joyless",
+	"joyride",
+	"jubilee",
+	"judging",
+	"jugular",
+	"juicier",
+	"jukebox",
+	"jumbled",
+	"juniper",
+	"junkies",
+	"justice",
+	"justify",
+	"kayaker",
+	"keenest",
+	"keeping",
+	"kennels",
+	"kestrel",
+	"ketchup",
+	"kettles",
+	"keyhole",
+	"keynote",
+	"kicking",
+	"kidding",
+	"killing",
+	"killjoy",
+	"kindled",
+	"kindred",
+	"kinetic",
+	"kingdom",
+	"kinship",
+	"kissing",
+	"kitchen",
+	"kneaded",
+	"knifing",
+	"knitted",
+	"knocker",
+	"knotted",
+	"knowing",
+	"knuckle",
+	"labeled",
+	"laborer",
+	"lacquer",
+	"ladybug",
+	"lagging",
+	"lambent",
+	"lampoon",
+	"landing",
+	"lanolin",
+	"lantern",
+	"lapwing",
+	"larceny",
+	"largely",
+	"largess",
+	"lassoed",
+	"lasting",
+	"lateral",
+	"lathers",
+	"latrine",
+	"lattice",
+	"laughed",
+	"launder",
+	"laundry",
+	"lawless",
+	"lawsuit",
+	"layered",
+	"layette",
+	"laziest",
+	"leading",
+	"leaflet",
+	"leakage",
+	"leaning",
+	"learned",
+	"leather",
+	"lectern",
+	"leeward",
+	"leftist",
+	"legally",
+	"legible",
+	"legroom",
+	"leisure",
+	"lemming",
+	"lengthy",
+	"lenient",
+	"leopard",
+	"leprosy",
+	"lettuce",
+	"leveled",
+	"leveret",
+	"liaison",
+	"liberal",
+	"liberty",
+	"library",
+	"licence",
+	"license",
+	"lichens",
+	"lighter",
+	"lignite",
+	"likable",
+	"limited",
+	"lineage",
+	"lingual",
+	"lioness",
+	"lipread",
+	"lissome",
+	"listing",
+	"literal",
+	"lithium",
+	"littler",
+	"livable",
+	"lobster",
+	"locally",
+	"located",
+	"lockjaw",
+	"lodging",
+	"loftily",
+	"logbook",
+	"logical",
+	"longbow",
+	"lookout",
+	"loosely",
+	"lorries",
+	"lottery",
+	"loudest",
+	"lounger",
+	"lovable",
+	"lowland",
+	"loyally",
+	"loyalty",
+	"lozenge",
+	"lucidly",
+	"luggage",
+	"lullaby",
+	"lumbago",
+	"luminal",
+	"lunatic",
+	"lurched",
+	"lushest",
+	"lyrical",
+	"macabre",
+	"machete",
+	"machine",
+	"madness",
+	"magenta",
+	"maggots",
+	"magical",
+	"magnate",
+	"magnify",
+	"mahjong",
+	"maidens",
+	"mailbox",
+	"majesty",
+	"malaise",
+	"mallard",
+	"mammoth",
+	"manacle",
+	"manager",
+	"mandate",
+	"mandrel",
+	"mangoes",
+	"manhole",
+	"manhunt",
+	"mankind",
+	"mannish",
+	"mansion",
+	"mantled",
+	"marbled",
+	"marcher",
+	"mariner",
+	"marital",
+	"marquee",
+	"marquis",
+	"married",
+	"marshal",
+	"martial",
+	"martini",
+	"marvels",
+	"mascara",
+	"massage",
+	"masseur",
+	"massive",
+	"mastery",
+	"matches",
+	"matinee",
+	"mattock",
+	"maudlin",
+	"maximal",
+	"maximum",
+	"mayoral",
+	"meander",
+	"meaning",
+	"measles",
+	"measure",
+	"meddler",
+	"mediate",
+	"medical",
+	"medulla",
+	"meeting",
+	"melange",
+	"mellows",
+	"melodic",
+	"memento",
+	"menaced",
+	"menfolk",
+	"menthol",
+	"mention",
+	"mermaid",
+	"merrily",
+	"message",
+	"messiah",
+	"metered",
+	"methane",
+	"midland",
+	"midriff",
+	"midterm",
+	"midwife",
+	"migrate",
+	"mildest",
+	"milkman",
+	"million",
+	"mimicry",
+	"minaret",
+	"mindful",
+	"mineral",
+	"minimal",
+	"minimum",
+	"minnows",
+	"minuets",
+	"miracle",
+	"mirrors",
+	"miscast",
+	"misdeed",
+	"miserly",
+	"misfire",
+	"misgive",
+	"mishaps",
+	"mislaid",
+	"misread",
+	"misrule",
+	"missing",
+	"mission",
+	"misstep",
+	"mistake",
+	"mistral",
+	"mixture",
+	"moaning",
+	"mobbing",
+	"mobster",
+	"mockery",
+	"modesty",
+	"modicum",
+	"modular",
+	"moisten",
+	"molders",
+	"mollify",
+	"monarch",
+	"mongrel",
+	"monitor",
+	"monocle",
+	"monsoon",
+	"monster",
+	"montage",
+	"monthly",
+	"moocher",
+	"moonlit",
+	"mooring",
+	"moraine",
+	"morally",
+	"mordant",
+	"morning",
+	"mortify",
+	"mosaics",
+	"mothers",
+	"mottled",
+	"mounted",
+	"mourner",
+	"mousing",
+	"mouthed",
+	"movable",
+	"muddily",
+	"muddled",
+	"muffled",
+	"muffler",
+	"mugging",
+	"mullein",
+	"mumbled",
+	"mummify",
+	"munched",
+	"mundane",
+	"murkily",
+	"murmurs",
+	"muscled",
+	"museful",
+	"mushily",
+	"musical",
+	"mustang",
+	"mustard",
+	"mutable",
+	"muzzled",
+	"myriads",
+	"mystery",
+	"mystify",
+	"naively",
+	"nakedly",
+	"napkins",
+	"narrate",
+	"narwhal",
+	"nascent",
+	"nastily",
+	"natural",
+	"naughty",
+	"neatest",
+	"nebulae",
+	"necktie",
+	"needful",
+	"neglect",
+	"neither",
+	"nemesis",
+	"neonate",
+	"nerving",
+	"nervous",
+	"netting",
+	"network",
+	"neutral",
+	"newborn",
+	"newness",
+	"newsman",
+	"nibbled",
+	"nightly",
+	"nimbler",
+	"nitrate",
+	"nodding",
+	"noisily",
+	"nomadic",
+	"nominal",
+	"nonstop",
+	"noodles",
+	"nostril",
+	"notable",
+	"notably",
+	"nothing",
+	"noticed",
+	"nourish",
+	"novelty",
+	"nowhere",
+	"noxious",
+	"nuclear",
+	"nucleus",
+	"nuggets",
+	"numbing",
+	"numeral",
+	"nunnery",
+	"nursery",
+	"nurture",
+	"nutmegs",
+	"nuzzled",
+	"oakwood",
+	"oarlock",
+	"oatmeal",
+	"obelisk",
+	"obesity",
+	"obliged",
+	"obscene",
+	"obscure",
+	"observe",
+	"obvious",
+	"octagon",
+	"octopus",
+	"oddball",
+	"odorous",
+	"odyssey",
+	"offbeat",
+	"offense",
+	"offhand",
+	"officer",
+	"offload",
+	"offside",
+	"oilskin",
+	"ominous",
+	"omnibus",
+	"onboard",
+	"onerous",
+	"oneself",
+	"ongoing",
+	"onshore",
+	"opaques",
+	"opening",
+	"operate",
+	"opinion",
+	"opossum",
+	"oppress",
+	"optical",
+	"optimal",
+	"opulent",
+	"oration",
+	"orbital",
+	"orchard",
+	"orderly",
+	"organic",
+	"orifice",
+	"origami",
+	"orphans",
+	"osmosis",
+	"ostrich",
+	"ottoman",
+	"outback",
+	"outbred",
+	"outcast",
+	"outcome",
+	"outdoor",
+	"outface",
+	"outflow",
+	"outgrow",
+	"outlast",
+	"outline",
+	"outlook",
+	"outpost",
+	"outrage",
+	"outrank",
+	"outside",
+	"outsize",
+	"outtake",
+	"outward",
+	"outwear",
+	"overact",
+	"overall",
+	"overawe",
+	"overdue",
+	"overeat",
+	"overlap",
+	"overlay",
+	"overrun",
+	"oversee",
+	"overtly",
+	"oxidize",
+	"pacific",
+	"package",
+	"packets",
+	"padding",
+	"paddock",
+	"padlock",
+	"pageant",
+	"pailful",
+	"painful",
+	"painted",
+	"painter",
+	"pajamas",
+	"palaces",
+	"palette",
+	"palmist",
+	"panacea",
+	"pancake",
+	"panicky",
+	"panther",
+	"papayas",
+	"papoose",
+	"paprika",
+	"parable",
+	"paradox",
+	"paragon",
+	"parapet",
+	"parasol",
+	"parched",
+	"parfait",
+	"parking",
+	"parlour",
+	"parquet",
+	"parsley",
+	"parsnip",
+	"partake",
+	"partial",
+	"partner",
+	"passage",
+	"passing",
+	"passion",
+	"passive",
+	"pastime",
+	"pasture",
+	"patella",
+	"pathway",
+	"patient",
+	"patriot",
+	"pattern",
+	"paucity",
+	"paunchy",
+	"payable",
+	"payment",
+	"payroll",
+	"peacock",
+	"peafowl",
+	"peanuts",
+	"pearled",
+	"peasant",
+	"pebbles",
+	"peckish",
+	"peddler",
+	"pedicab",
+	"peeling",
+	"peerage",
+	"peeress",
+	"pelican",
+	"penalty",
+	"penance",
+	"pendant",
+	"pending",
+	"penguin",
+	"pennant",
+	"pension",
+	"pensive",
+	"peppery",
+	"percent",
+	"perfect",
+	"perform",
+	"perfume",
+	"perhaps",
+	"perjury",
+	"permits",
+	"perplex",
+	"persist",
+	"persona",
+	"pertain",
+	"perturb",
+	"peruses",
+	"pervade",
+	"pervert",
+	"petrify",
+	"pettish",
+	"petunia",
+	"phantom",
+	"pharaoh",
+	"philter",
+	"phobias",
+	"phoenix",
+	"phonics",
+	"photons",
+	"phrased",
+	"physics",
+	"pianist",
+	"piccolo",
+	"pickaxe",
+	"picking",
+	"pickled",
+	"picture",
+	"piebald",
+	"pigeons",
+	"piggish",
+	"pigskin",
+	"pigtail",
+	"pilgrim",
+	"pillage",
+	"pillbox",
+	"pimento",
+	"pinball",
+	"pincers",
+	"pinhole",
+	"pinkish",
+	"pinnace",
+	"pioneer",
+	"piously",
+	"piquant",
+	"piranha",
+	"pitfall",
+	"pithead",
+	"pitiful",
+	"pivotal",
+	"pizzazz",
+	"placard",
+	"placate",
+	"placebo",
+	"plainly",
+	"plaster",
+	"plastic",
+	"plateau",
+	"platoon",
+	"platter",
+	"players",
+	"playful",
+	"pleaded",
+	"pleased",
+	"plenary",
+	"pliable",
+	"plodded",
+	"plotted",
+	"plowman",
+	"pluming",
+	"plummet",
+	"plunder",
+	"plywood",
+	"poacher",
+	"pockets",
+	"podcast",
+	"poetess",
+	"poetics",
+	"pointed",
+	"pointer",
+	"poisons",
+	"polecat",
+	"polemic",
+	"politic",
+	"pollute",
+	"polygon",
+	"pompous",
+	"poniard",
+	"pontiff",
+	"pontoon",
+	"popcorn",
+	"popular",
+	"porcine",
+	"portend",
+	"portion",
+	"portray",
+	"possess",
+	"postage",
+	"postbox",
+	"posture",
+	"potency",
+	"pothole",
+	"pottery",
+	"poultry",
+	"pounced",
+	"poverty",
+	"powered",
+	"prairie",
+	"praline",
+	"prattle",
+	"preachy",
+	"precede",
+	"precise",
+	"predict",
+	"preface",
+	"prelude",
+	"premier",
+	"premise",
+	"premium",
+	"prepaid",
+	"prepare",
+	"presage",
+	"present",
+	"preside",
+	"presume",
+	"pretend",
+	"pretext",
+	"prevail",
+	"prevent",
+	"preview",
+	"prickle",
+	"primacy",
+	"primary",
+	"primate",
+	"printer",
+	"prithee",
+	"privacy",
+	"private",
+	"probate",
+	"probity",
+	"problem",
+	"proceed",
+	"process",
+	"proctor",
+	"procure",
+	"prodigy",
+	"produce",
+	"product",
+	"profane",
+	"profess",
+	"profile",
+	"profuse",
+	"progeny",
+	"program",
+	"project",
+	"prolong",
+	"promise",
+	"promote",
+	"pronoun",
+	"propane",
+	"prophet",
+	"propose",
+	"prosaic",
+	"prosper",
+	"protect",
+	"protein",
+	"protest",
+	"proudly",
+	"proverb",
+	"provide",
+	"proviso",
+	"provoke",
+	"prowess",
+	"prowler",
+	"prudent",
+	"prudish",
+	"psalter",
+	"psychic",
+	"puberty",
+	"publish",
+	"puckish",
+	"pudding",
+	"puddled",
+	"puffery",
+	"puffins",
+	"pullets",
+	"pullout",
+	"pulsing",
+	"pumpkin",
+	"punched",
+	"puncher",
+	"pundits",
+	"pungent",
+	"punster",
+	"puritan",
+	"purloin",
+	"purport",
+	"purpose",
+	"pursuit",
+	"pushing",
+	"pustule",
+	"putrefy",
+	"puzzler",
+	"pyramid",
+	"quacked",
+	"quaffed",
+	"quailed",
+	"quaking",
+	"qualify",
+	"quality",
+	"quantum",
+	"quarrel",
+	"quarter",
+	"quartet",
+	"quavery",
+	"queenly",
+	"queried",
+	"quibble",
+	"quicken",
+	"quickly",
+	"quieted",
+	"quietly",
+	"quietus",
+	"quilted",
+	"quinine",
+	"quintet",
+	"quipped",
+	"quitter",
+	"quivers",
+	"quizzed",
+	"quoting",
+	"rabbits",
+	"raccoon",
+	"racquet",
+	"radiant",
+	"radiate",
+	"radical",
+	"radioed",
+	"raffish",
+	"ragtime",
+	"railing",
+	"railway",
+	"raiment",
+	"rainbow",
+	"raining",
+	"rambled",
+	"rampage",
+	"rampant",
+	"rancher",
+	"rancour",
+	"ransack",
+	"rapidly",
+	"rapport",
+	"rapture",
+	"rarebit",
+	"rashers",
+	"raspier",
+	"ratchet",
+	"rations",
+	"rattled",
+	"raucous",
+	"ravaged",
+	"ravioli",
+	"rawhide",
+	"reached",
+	"reactor",
+	"readily",
+	"reading",
+	"readout",
+	"realism",
+	"realist",
+	"reality",
+	"realize",
+	"realtor",
+	"reaping",
+	"rearing",
+	"reasons",
+	"rebated",
+	"rebound",
+	"rebuild",
+	"rebuked",
+	"receipt",
+	"receive",
+	"recital",
+	"recited",
+	"reclaim",
+	"recline",
+	"recluse",
+	"recover",
+	"recruit",
+	"rectify",
+	"recycle",
+	"redhead",
+	"redness",
+	"redoubt",
+	"redound",
+	"redress",
+	"reentry",
+	"referee",
+	"reflect",
+	"refrain",
+	"refresh",
+	"refugee",
+	"refusal",
+	"refuted",
+	"regalia",
+	"regally",
+	"regatta",
+	"regency",
+	"regimen",
+	"regroup",
+	"regular",
+	"rehouse",
+	"reissue",
+	"rejoice",
+	"relapse",
+	"related",
+	"relaxed",
+	"relayed",
+	"release",
+	"reliant",
+	"relieve",
+	"relight",
+	"remains",
+	"remarks",
+	"remnant",
+	"remorse",
+	"remould",
+	"removal",
+	"removed",
+	"renewal",
+	"rentals",
+	"repaint",
+	"repairs",
+	"replace",
+	"replete",
+	"replica",
+	"reposed",
+	"reprise",
+	"reproof",
+	"reptile",
+	"repulse",
+	"request",
+	"require",
+	"reredos",
+	"rescind",
+	"reserve",
+	"reshape",
+	"residue",
+	"resolve",
+	"resound",
+	"respect",
+	"respire",
+	"respite",
+	"respond",
+	"resting",
+	"restive",
+	"restore",
+	"retched",
+	"retinue",
+	"retired",
+	"retouch",
+	"retract",
+	"retread",
+	"retreat",
+	"retrial",
+	"reunion",
+	"reunite",
+	"revelry",
+	"revenge",
+	"revenue",
+	"reverie",
+	"reverse",
+	"revisit",
+	"revival",
+	"revoked",
+	"revolve",
+	"reworks",
+	"rhubarb",
+	"rhyming",
+	"ribbing",
+	"richest",
+	"riddled",
+	"ridging",
+	"rifling",
+	"rigging",
+	"righted",
+	"rightly",
+	"rigidly",
+	"ringlet",
+	"ripcord",
+	"riposte",
+	"ripples",
+	"riskier",
+	"ritzier",
+	"rivalry",
+	"riveted",
+	"roaming",
+	"roaring",
+	"roasted",
+	"robbery",
+	"rockery",
+	"roguish",
+	"rollick",
+	"rolling",
+	"romance",
+	"rompers",
+	"rooftop",
+	"rookery",
+	"roomful",
+	"rooster",
+	"rosebud",
+	"rotunda",
+	"roughen",
+	"roughly",
+	"rounded",
+	"roundly",
+	"rousing",
+	"routine",
+	"rowboat",
+	"royally",
+	"rubbish",
+	"rubdown",
+	"rudders",
+	"ruffian",
+	"ruinous",
+	"rumbled",
+	"rummage",
+	"rumpled",
+	"runaway",
+	"running",
+	"rupture",
+	"rustled",
+	"sabbath",
+	"saccade",
+	"sackful",
+	"saddled",
+	"sadness",
+	"saffron",
+	"sailing",
+	"saintly",
+	"salable",
+	"salient",
+	"sallied",
+	"salsify",
+	"saltine",
+	"saluted",
+	"salvage",
+	"samovar",
+	"samurai",
+	"sandals",
+	"sandbag",
+	"sandbar",
+	"sapling",
+	"sarcasm",
+	"sardine",
+	"sashimi",
+	"satchel",
+	"satiate",
+	"satiric",
+	"satisfy",
+	"saucily",
+	"saunter",
+	"sausage",
+	"savanna",
+	"saviour",
+	"savored",
+	"sawdust",
+	"sawmill",
+	"scabbed",
+	"scalded",
+	"scallop",
+	"scalpel",
+	"scamper",
+	"scandal",
+	"scanner",
+	"scarcer",
+	"scarlet",
+	"scarves",
+	"scatter",
+	"scenery",
+	"scented",
+	"scepter",
+	"schemer",
+	"scholar",
+	"schools",
+	"science",
+	"scissor",
+	"scoffed",
+	"scolded",
+	"scooped",
+	"scooter",
+	"scorned",
+	"scourge",
+	"scowled",
+	"scraped",
+	"scratch",
+	"scrawny",
+	"screech",
+	"scripts",
+	"scrubby",
+	"scruffy",
+	"scrunch",
+	"scruple",
+	"sculpts",
+	"scuttle",
+	"seafood",
+	"sealant",
+	"seaport",
+	"seasick",
+	"seaside",
+	"seasons",
+	"seating",
+	"seaweed",
+	"seclude",
+	"secrecy",
+	"secrets",
+	"section",
+	"secular",
+	"seedbed",
+	"seeding",
+	"seeking",
+	"seepage",
+	"segment",
+	"seismic",
+	"seizure",
+	"selfish",
+	"seminar",
+	"senator",
+	"sensory",
+	"sequins",
+	"serious",
+	"serpent",
+	"servant",
+	"service",
+	"serving",
+	"sessile",
+	"session",
+	"setback",
+	"setting",
+	"settled",
+	"seventh",
+	"seventy",
+	"several",
+	"shackle",
+	"shadowy",
+	"shallot",
+	"shallow",
+	"shamble",
+	"shampoo",
+	"shapely",
+	"sharing",
+	"sharpen",
+	"shatter",
+	"shaving",
+	"shearer",
+	"sheathe",
+	"shelter",
+	"shelves",
+	"sherbet",
+	"shindig",
+	"shingle",
+	"shining",
+	"shipper",
+	"shirker",
+	"shivers",
+	"shocker",
+	"shorten",
+	"shortly",
+	"shouted",
+	"showman",
+	"shrieks",
+	"shrilly",
+	"shrivel",
+	"shudder",
+	"shuffle",
+	"shutter",
+	"shuttle",
+	"shyness",
+	"sickbay",
+	"sickbed",
+	"sidecar",
+	"sighted",
+	"signals",
+	"signify",
+	"silence",
+	"silicon",
+	"silvery",
+	"similar",
+	"simmers",
+	"sincere",
+	"sinking",
+	"sinuous",
+	"siphons",
+	"sisters",
+	"sitting",
+	"situate",
+	"sixteen",
+	"sizable",
+	"sketchy",
+	"skilful",
+	"skilled",
+	"skillet",
+	"skimmed",
+	"skinned",
+	"skipper",
+	"skirted",
+	"skulked",
+	"skyline",
+	"skyward",
+	"slacken",
+	"slander",
+	"slashed",
+	"slather",
+	"slavish",
+	"sleeper",
+	"sleeved",
+	"sleight",
+	"slender",
+	"slicker",
+	"slimmer",
+	"slipper",
+	"slither",
+	"slobber",
+	"slogans",
+	"sloshed",
+	"slotted",
+	"slowing",
+	"slumber",
+	"slurred",
+	"smaller",
+	"smarted",
+	"smartly",
+	"smashed",
+	"smeared",
+	"smelled",
+	"smidgen",
+	"smiling",
+	"smitten",
+	"smokers",
+	"smoking",
+	"smolder",
+	"smother",
+	"snagged",
+	"snakier",
+	"snapped",
+	"snarled",
+	"sneaked",
+	"sneered",
+	"snicker",
+	"sniffed",
+	"sniffle",
+	"snifter",
+	"snigger",
+	"snipped",
+	"snippet",
+	"snooper",
+	"snoring",
+	"snorkel",
+	"snowcap",
+	"snowing",
+	"snuggle",
+	"soaking",
+	"soapbox",
+	"soaring",
+	"sobered",
+	"society",
+	"sockets",
+	"soggier",
+	"sojourn",
+	"soldier",
+	"solicit",
+	"soloist",
+	"solvent",
+	"somehow",
+	"someone",
+	"soprano",
+	"sorcery",
+	"sorghum",
+	"sorrows",
+	"soulful",
+	"sounded",
+	"soundly",
+	"sourced",
+	"souring",
+	"soybean",
+	"spacing",
+	"spangle",
+	"spaniel",
+	"spanner",
+	"sparing",
+	"sparked",
+	"sparkle",
+	"sparrow",
+	"spartan",
+	"spatula",
+	"speaker",
+	"special",
+	"species",
+	"specify",
+	"speckle",
+	"spectra",
+	"speeded",
+	"speedup",
+	"spelled",
+	"spender",
+	"spicily",
+	"spidery",
+	"spiking",
+	"spinach",
+	"spindle",
+	"spinner",
+	"spinoff",
+	"spittle",
+	"splashy",
+	"splurge",
+	"spoiled",
+	"sponged",
+	"sponsor",
+	"spotted",
+	"spotter",
+	"spouted",
+	"sprayed",
+	"springy",
+	"sprites",
+	"spruced",
+	"spurned",
+	"spurted",
+	"squalid",
+	"squalor",
+	"squared",
+	"squashy",
+	"squeaky",
+	"squeeze",
+	"squelch",
+	"squinty",
+	"squirmy",
+	"squirts",
+	"stabbed",
+	"stabled",
+	"stacked",
+	"stadium",
+	"stagger",
+	"staidly",
+	"stained",
+	"staking",
+	"stalled",
+	"stamina",
+	"stammer",
+	"stamped",
+	"standby",
+	"stapler",
+	"starchy",
+	"stardom",
+	"staring",
+	"starkly",
+	"starlet",
+	"started",
+	"startle",
+	"starved",
+	"stately",
+	"statics",
+	"station",
+	"statute",
+	"staunch",
+	"stealth",
+	"steamed",
+	"steeple",
+	"stellar",
+	"stemmed",
+	"stencil",
+	"stepson",
+	"sterile",
+	"sternly",
+	"steward",
+	"sticker",
+	"stiffen",
+	"stilted",
+	"stimuli",
+	"stinger",
+	"stipend",
+	"stirrup",
+	"stoical",
+	"stomach",
+	"stonier",
+	"stooped",
+	"stopgap",
+	"stopper",
+	"storage",
+	"stories",
+	"stouter",
+	"stowage",
+	"strafed",
+	"strange",
+	"stratum",
+	"strayed",
+	"stretch",
+	"strewed",
+	"striker",
+	"strings",
+	"striped",
+	"stroked",
+	"strudel",
+	"stubble",
+	"stubbly",
+	"studded",
+	"student",
+	"studied",
+	"stuffed",
+	"stumble",
+	"stunned",
+	"stunted",
+	"stupefy",
+	"stutter",
+	"stylish",
+	"stymied",
+	"suavely",
+	"subdued",
+	"subject",
+	"sublime",
+	"subsidy",
+	"subsist",
+	"subsume",
+	"subtext",
+	"subvert",
+	"succeed",
+	"success",
+	"succour",
+	"succumb",
+	"suckled",
+	"suction",
+	"suffice",
+	"suggest",
+	"suicide",
+	"suiting",
+	"sulfate",
+	"sulkily",
+	"sultana",
+	"summary",
+	"summery",
+	"summons",
+	"sunbath",
+	"sunbeam",
+	"sunburn",
+	"sundial",
+	"sunfish",
+	"sunlamp",
+	"sunless",
+	"sunrise",
+	"sunroof",
+	"sunspot",
+	"support",
+	"suppose",
+	"supreme",
+	"surface",
+	"surfeit",
+	"surgeon",
+	"surgery",
+	"surmise",
+	"surname",
+	"surpass",
+	"surplus",
+	"surreal",
+	"survive",
+	"suspect",
+	"suspend",
+	"sustain",
+	"swagger",
+	"swallow",
+	"swarthy",
+	"swathed",
+	"swearer",
+	"sweater",
+	"sweeper",
+	"sweeten",
+	"swelter",
+	"swerved",
+	"swiftly",
+	"swimmer",
+	"swindle",
+	"swinger",
+	"swirled",
+	"swollen",
+	"swooped",
+	"sylphid",
+	"symptom",
+	"syncope",
+	"synergy",
+	"synonym",
+	"syringe",
+	"systems",
+	"tabloid",
+	"tacitly",
+	"tackler",
+	"tactics",
+	"tadpole",
+	"taffeta",
+	"tailing",
+	"takeoff",
+	"talkies",
+	"tallest",
+	"tallied",
+	"tallyho",
+	"tangelo",
+	"tangent",
+	"tangled",
+	"tankard",
+	"tantrum",
+	"tapioca",
+	"tarnish",
+	"tarried",
+	"tartans",
+	"tasking",
+	"tasting",
+	"tattler",
+	"taunted",
+	"taverns",
+	"taxable",
+	"teacher",
+	"teaming",
+	"teapots",
+	"tearful",
+	"teargas",
+	"tedious",
+	"teeming",
+	"teenage",
+	"telling",
+	"tempest",
+	"tempted",
+	"tenable",
+	"tenancy",
+	"tendril",
+	"tenfold",
+	"tension",
+	"tenuous",
+	"terrace",
+	"terrain",
+	"terrier",
+	"terrify",
+	"tersely",
+	"testate",
+	"testify",
+	"textile",
+	"texture",
+	"thawing",
+	"theater",
+	"theatre",
+	"theorem",
+	"therapy",
+	"thereby",
+	"thermal",
+	"thicken",
+	"thicket",
+	"thimble",
+	"thinker",
+	"thirsty",
+	"thistle",
+	"thither",
+	"thought",
+	"thrifty",
+	"throats",
+	"through",
+	"thrower",
+	"thudded",
+	"thunder",
+	"thwacks",
+	"ticking",
+	"tickled",
+	"tidings",
+	"tighten",
+	"tightly",
+	"timbers",
+	"timidly",
+	"tinfoil",
+	"tingled",
+	"tinkled",
+	"tinnier",
+	"tiptoed",
+	"tiredly",
+	"tissues",
+	"titanic",
+	"titular",
+	"toaster",
+	"tobacco",
+	"toddler",
+	"toenail",
+	"toffees",
+	"tolling",
+	"tombola",
+	"tonight",
+	"tonnage",
+	"tonsils",
+	"toolbox",
+	"toothed",
+	"topical",
+	"topknot",
+	"topmast",
+	"topping",
+	"topsoil",
+	"torment",
+	"tornado",
+	"torpedo",
+	"torrent",
+	"torture",
+	"tossing",
+	"totally",
+	"toucans",
+	"touched",
+	"toughen",
+	"tourism",
+	"tourist",
+	"towards",
+	"towered",
+	"toxemia",
+	"tracker",
+	"tractor",
+	"trading",
+	"traffic",
+	"tragedy",
+	"trailer",
+	"trainee",
+	"trainer",
+	"traitor",
+	"tramped",
+	"trample",
+	"transit",
+	"trapeze",
+	"trapped",
+	"travail",
+	"trawler",
+	"treacle",
+	"treadle",
+	"treason",
+	"treated",
+	"trellis",
+	"tremble",
+	"tremolo",
+	"trestle",
+	"tribune",
+	"tribute",
+	"trickle",
+	"tricorn",
+	"trident",
+	"trifled",
+	"trigger",
+	"trilogy",
+	"trimmed",
+	"trinity",
+	"trinket",
+	"triumph",
+	"trivial",
+	"trolley",
+	"trollop",
+	"trophic",
+	"trouble",
+	"trounce",
+	"trouper",
+	"truancy",
+	"truckle",
+	"trudged",
+	"truffle",
+	"trumpet",
+	"trundle",
+	"trustee",
+	"tsunami",
+	"tubular",
+	"tugboat",
+	"tuition",
+	"tumbler",
+	"tumults",
+	"tuneful",
+	"turbans",
+	"turbine",
+	"turkeys",
+	"turmoil",
+	"turning",
+	"turnips",
+	"turnkey",
+	"turnout",
+	"tussock",
+	"tutored",
+	"twaddle",
+	"tweaked",
+	"tweeted",
+	"twelfth",
+	"twiddle",
+	"twinkle",
+	"twirled",
+	"twisted",
+	"twister",
+	"twofold",
+	"tycoons",
+	"typeset",
+	"typhoon",
+	"typical",
+	"tyranny",
+	"ugliest",
+	"ululate",
+	"umpires",
+	"unaware",
+	"unbound",
+	"unbowed",
+	"uncanny",
+	"unchain",
+	"unclean",
+	"unclear",
+	"uncouth",
+	"uncover",
+	"unction",
+	"undergo",
+	"undoing",
+	"undress",
+	"unearth",
+	"unequal",
+	"unfazed",
+	"unfrock",
+	"unfurls",
+	"ungodly",
+	"unguent",
+	"unhappy",
+	"unheard",
+	"unhinge",
+	"unicorn",
+	"uniform",
+	"unkempt",
+	"unknown",
+	"unlatch",
+	"unleash",
+	"unlined",
+	"unloose",
+	"unlucky",
+	"unmanly",
+	"unmoved",
+	"unnerve",
+	"unpaved",
+	"unquote",
+	"unravel",
+	"unready",
+	"unscrew",
+	"unsound",
+	"unstuck",
+	"untried",
+	"untruth",
+	"unusual",
+	"unwound",
+	"upbraid",
+	"upfront",
+	"upgrade",
+	"upheave",
+	"uphills",
+	"upright",
+	"upriver",
+	"upscale",
+	"upstage",
+	"upstart",
+	"upswing",
+	"uptight",
+	"uranium",
+	"urbanly",
+	"urchins",
+	"urgency",
+	"urinary",
+	"useless",
+	"usually",
+	"usurped",
+	"utensil",
+	"utility",
+	"utopian",
+	"utterly",
+	"vacancy",
+	"vaccine",
+	"vacuity",
+	"vaguely",
+	"valance",
+	"valence",
+	"valiant",
+	"validly",
+	"valleys",
+	"valuate",
+	"vampire",
+	"vanilla",
+	"vantage",
+	"vaquero",
+	"variant",
+	"variety",
+	"various",
+	"varmint",
+	"varnish",
+	"varsity",
+	"vaulted",
+	"vaunted",
+	"vehicle",
+	"velvety",
+	"vendors",
+	"veneers",
+	"venison",
+	"ventral",
+	"venture",
+	"veranda",
+	"verbena",
+	"verdict",
+	"verdure",
+	"verging",
+	"vermeil",
+	"version",
+	"vertigo",
+	"vervain",
+	"vespers",
+	"vessels",
+	"vestige",
+	"veteran",
+	"vetting",
+	"vibrato",
+	"viceroy",
+	"vicious",
+	"victory",
+	"viewing",
+	"village",
+	"villain",
+	"vinegar",
+	"vintage",
+	"violate",
+	"violent",
+	"violets",
+	"virgins",
+	"virtual",
+	"viscera",
+	"visible",
+	"visitor",
+	"vitally",
+	"vitamin",
+	"vividly",
+	"vocally",
+	"volcano",
+	"voltage",
+	"voluble",
+	"vomited",
+	"voucher",
+	"voyager",
+	"vulture",
+	"wadding",
+	"waddled",
+	"waffled",
+	"waggish",
+	"waggled",
+	"wailing",
+	"waiting",
+	"wakeful",
+	"walking",
+	"walkout",
+	"walkway",
+	"wallaby",
+	"walleye",
+	"wallows",
+	"walnuts",
+	"wangled",
+	"wanting",
+	"warbler",
+	"wardens",
+	"warfare",
+	"warhead",
+	"warlike",
+	"warlock",
+	"warlord",
+	"warmest",
+	"warning",
+	"warpath",
+	"warrant",
+	"warring",
+	"warrior",
+	"warship",
+	"wartime",
+	"washday",
+	"washing",
+	"washout",
+	"washtub",
+	"wasting",
+	"wastrel",
+	"watched",
+	"watcher",
+	"watered",
+	"wattage",
+	"wavelet",
+	"waverer",
+	"waxwing",
+	"waxwork",
+	"wayside",
+	"wayward",
+	"wealthy",
+	"weaning",
+	"weapons",
+	"wearied",
+	"wearily",
+	"weasels",
+	"weather",
+	"weaving",
+	"webbing",
+	"webcast",
+	"website",
+	"wedding",
+	"wedlock",
+	"weekday",
+	"weekend",
+	"weeping",
+	"weighty",
+	"weirdly",
+	"welcome",
+	"welfare",
+	"wellies",
+	"welling",
+	"western",
+	"wetland",
+	"wettest",
+	"whacked",
+	"whaling",
+	"wheedle",
+	"wheeled",
+	"wheezed",
+	"whereas",
+	"whereby",
+	"whether",
+	"whetted",
+	"whiffed",
+	"whimper",
+	"whipped",
+	"whippet",
+	"whirled",
+	"whisked",
+	"whisker",
+	"whiskey",
+	"whisper",
+	"whistle",
+	"whitish",
+	"whittle",
+	"whizzed",
+	"whoever",
+	"whoopee",
+	"whopper",
+	"widened",
+	"widower",
+	"wielded",
+	"wigwams",
+	"wildcat",
+	"willful",
+	"willing",
+	"willowy",
+	"wimpled",
+	"windbag",
+	"windily",
+	"winding",
+	"windows",
+	"winking",
+	"winners",
+	"winning",
+	"winsome",
+	"wintery",
+	"wiretap",
+	"wishful",
+	"wistful",
+	"witched",
+	"withers",
+	"without",
+	"witless",
+	"witness",
+	"wittily",
+	"wizards",
+	"wobbled",
+	"wolfish",
+	"womanly",
+	"wonders",
+	"wonkier",
+	"woodcut",
+	"woodman",
+	"woolens",
+	"wording",
+	"workday",
+	"workers",
+	"working",
+	"workman",
+	"workout",
+	"worldly",
+	"worried",
+	"worries",
+	"worship",
+	"worsted",
+	"wounded",
+	"wrangle",
+	"wrapper",
+	"wreathe",
+	"wrecked",
+	"wrecker",
+	"wrestle",
+	"wriggle",
+	"wringer",
+	"wrinkle",
+	"writing",
+	"written",
+	"wrongly",
+	"wrought",
+	"yardarm",
+	"yeaning",
+	"yearned",
+	"yelling",
+	"yellows",
+	"yelping",
+	"yeshiva",
+	"yielded",
+	"younger",
+	"zealots",
+	"zealous",
+	"zestful",
+	"zillion",
+	"zippers",
+	"zipping",
+	"zoology",
+	"zooming",
+}
@@ -0,0 +1,3024 @@
+package words
+
+var words8 = []string{
+	"athletic",
+	"notebook",
+	"dedicate",
+	"domestic",
+	"happened",
+	"accident",
+	"commence",
+	"diabetes",
+	"computer",
+	"threaten",
+	"adjacent",
+	"medicine",
+	"specific",
+	"position",
+	"confused",
+	"civilian",
+	"interact",
+	"standing",
+	"parallel",
+	"ultimate",
+	"pregnant",
+	"previous",
+	"property",
+	"explicit",
+	"separate",
+	"relative",
+	"vertical",
+	"measured",
+	"homeless",
+	"troubled",
+	"artistic",
+	"division",
+	"protocol",
+	"terminal",
+	"tomorrow",
+	"supposed",
+	"donation",
+	"imperial",
+	"organize",
+	"proposal",
+	"intimate",
+	"reasoned",
+	"navigate",
+	"interior",
+	"surround",
+	"platform",
+	"absolute",
+	"building",
+	"retailer",
+	"composed",
+	"perceive",
+	"survivor",
+	"equality",
+	"politics",
+	"exchange",
+	"engaging",
+	"planning",
+	"doorstep",
+	"petition",
+	"mobility",
+	"finished",
+	"alliance",
+	"endeavor",
+	"disorder",
+	"yourself",
+	"pursuing",
+	"disaster",
+	"doctrine",
+	"standard",
+	"document",
+	"realized",
+	"religion",
+	"teenager",
+	"informal",
+	"merchant",
+	"ceremony",
+	"attached",
+	"aviation",
+	"possible",
+	"estimate",
+	"nineteen",
+	"warranty",
+	"minister",
+	"response",
+	"contract",
+	"molecule",
+	"business",
+	"revision",
+	"powerful",
+	"rainfall",
+	"violence",
+	"momentum",
+	"security",
+	"struggle",
+	"exciting",
+	"customer",
+	"envelope",
+	"symbolic",
+	"opponent",
+	"familiar",
+	"movement",
+	"external",
+	"patience",
+	"coverage",
+	"southern",
+	"triangle",
+	"marriage",
+	"optional",
+	"advanced",
+	"oriented",
+	"printing",
+	"weakness",
+	"peaceful",
+	"tropical",
+	"audience",
+	"intended",
+	"peculiar",
+	"dialogue",
+	"unlikely",
+	"wherever",
+	"equation",
+	"validity",
+	"minimize",
+	"pleasant",
+	"achieved",
+	"provider",
+	"detector",
+	"national",
+	"hardware",
+	"consider",
+	"umbrella",
+	"universe",
+	"emerging",
+	"economic",
+	"morality",
+	"telegram",
+	"decision",
+	"autonomy",
+	"suburban",
+	"circular",
+	"dominant",
+	"ruthless",
+	"declared",
+	"received",
+	"probable",
+	"distance",
+	"superior",
+	"conflict",
+	"function",
+	"strategy",
+	"capacity",
+	"grateful",
+	"seasonal",
+	"flexible",
+	"stunning",
+	"convince",
+	"integral",
+	"exposure",
+	"actually",
+	"somebody",
+	"particle",
+	"observer",
+	"elevator",
+	"tactical",
+	"anything",
+	"dramatic",
+	"sunlight",
+	"relation",
+	"promptly",
+	"anywhere",
+	"whenever",
+	"clearing",
+	"pressure",
+	"interest",
+	"accuracy",
+	"shoulder",
+	"minority",
+	"included",
+	"sandwich",
+	"presence",
+	"entrance",
+	"analysis",
+	"strength",
+	"approval",
+	"landlord",
+	"prisoner",
+	"positive",
+	"properly",
+	"inspired",
+	"register",
+	"province",
+	"official",
+	"medieval",
+	"floating",
+	"genocide",
+	"chemical",
+	"friendly",
+	"original",
+	"offering",
+	"database",
+	"employer",
+	"governor",
+	"isolated",
+	"identify",
+	"surprise",
+	"occasion",
+	"woodland",
+	"emphasis",
+	"syndrome",
+	"upstairs",
+	"pendulum",
+	"somewhat",
+	"periodic",
+	"disabled",
+	"preserve",
+	"priority",
+	"clinical",
+	"aluminum",
+	"literacy",
+	"discount",
+	"district",
+	"informed",
+	"directly",
+	"keyboard",
+	"deciding",
+	"template",
+	"contrast",
+	"involved",
+	"midnight",
+	"sympathy",
+	"handling",
+	"overlook",
+	"ministry",
+	"resident",
+	"distinct",
+	"prospect",
+	"invasion",
+	"hesitate",
+	"unstable",
+	"eligible",
+	"mortgage",
+	"commerce",
+	"bulletin",
+	"software",
+	"variable",
+	"sentence",
+	"earnings",
+	"investor",
+	"covering",
+	"corridor",
+	"research",
+	"browsing",
+	"approach",
+	"relevant",
+	"vineyard",
+	"location",
+	"schedule",
+	"elephant",
+	"maintain",
+	"workshop",
+	"heritage",
+	"maximize",
+	"marginal",
+	"summoned",
+	"uncommon",
+	"engineer",
+	"bachelor",
+	"republic",
+	"dwelling",
+	"accepted",
+	"critical",
+	"predator",
+	"fragment",
+	"precious",
+	"enormous",
+	"delivery",
+	"leverage",
+	"ordinary",
+	"selected",
+	"breaking",
+	"scenario",
+	"ideology",
+	"feedback",
+	"adequate",
+	"academic",
+	"deadline",
+	"occupied",
+	"casualty",
+	"treasure",
+	"apparent",
+	"secretly",
+	"appendix",
+	"delegate",
+	"starting",
+	"practice",
+	"generate",
+	"restrict",
+	"internal",
+	"boundary",
+	"magazine",
+	"increase",
+	"wireless",
+	"inherent",
+	"supplier",
+	"teaching",
+	"champion",
+	"fraction",
+	"comprise",
+	"touching",
+	"survival",
+	"defender",
+	"premises",
+	"cultural",
+	"baseball",
+	"physical",
+	"indicate",
+	"identity",
+	"evaluate",
+	"contrary",
+	"consumer",
+	"stimulus",
+	"affected",
+	"outbreak",
+	"assembly",
+	"vitamins",
+	"learning",
+	"abstract",
+	"festival",
+	"entirely",
+	"exercise",
+	"publicly",
+	"chairman",
+	"updating",
+	"temporal",
+	"everyday",
+	"regional",
+	"activity",
+	"creation",
+	"suitable",
+	"opposite",
+	"scrutiny",
+	"valuable",
+	"likewise",
+	"compound",
+	"facility",
+	"material",
+	"transfer",
+	"calendar",
+	"question",
+	"whatever",
+	"reliable",
+	"breathed",
+	"duration",
+	"attitude",
+	"numerous",
+	"bacteria",
+	"recovery",
+	"tracking",
+	"industry",
+	"monetary",
+	"guidance",
+	"colonial",
+	"describe",
+	"incident",
+	"mountain",
+	"constant",
+	"reporter",
+	"together",
+	"solution",
+	"reaction",
+	"electric",
+	"concrete",
+	"currency",
+	"adjusted",
+	"thinking",
+	"congress",
+	"category",
+	"generous",
+	"rotation",
+	"continue",
+	"headline",
+	"prohibit",
+	"election",
+	"withdraw",
+	"discover",
+	"historic",
+	"aircraft",
+	"traveled",
+	"resource",
+	"everyone",
+	"educated",
+	"accurate",
+	"diameter",
+	"evidence",
+	"decrease",
+	"military",
+	"severely",
+	"volatile",
+	"attorney",
+	"pleasure",
+	"literary",
+	"dressing",
+	"maturity",
+	"sequence",
+	"complete",
+	"emission",
+	"crossing",
+	"painting",
+	"lifetime",
+	"upcoming",
+	"persuade",
+	"speaking",
+	"striking",
+	"employee",
+	"sweeping",
+	"meantime",
+	"terrible",
+	"operator",
+	"required",
+	"conclude",
+	"clothing",
+	"hospital",
+	"extended",
+	"moderate",
+	"drinking",
+	"indirect",
+	"normally",
+	"quantity",
+	"innocent",
+	"negative",
+	"complain",
+	"criminal",
+	"profound",
+	"junction",
+	"although",
+	"memorial",
+	"tendency",
+	"optimism",
+	"colorful",
+	"shortage",
+	"neighbor",
+	"daughter",
+	"magnetic",
+	"pipeline",
+	"laughter",
+	"becoming",
+	"princess",
+	"detailed",
+	"eventual",
+	"argument",
+	"populate",
+	"campaign",
+	"forecast",
+	"spectrum",
+	"overcome",
+	"frequent",
+	"assuming",
+	"slightly",
+	"brochure",
+	"disposal",
+	"portrait",
+	"catalyst",
+	"purchase",
+	"formerly",
+	"thousand",
+	"interval",
+	"guardian",
+	"majority",
+	"dividend",
+	"producer",
+	"addition",
+	"remember",
+	"designer",
+	"judgment",
+	"birthday",
+	"graphics",
+	"sporting",
+	"thankful",
+	"wildlife",
+	"multiple",
+	"language",
+	"frontier",
+	"initiate",
+	"judicial",
+	"instance",
+	"advocate",
+	"personal",
+	"graduate",
+	"daylight",
+	"limiting",
+	"simulate",
+	"director",
+	"collapse",
+	"bathroom",
+	"announce",
+	"progress",
+	"creative",
+	"children",
+	"football",
+	"weighted",
+	"firewall",
+	"delicate",
+}
+
+var valid8 = []string{
+	"abducted",
+	"aberrant",
+	"ablution",
+	"abnormal",
+	"abortion",
+	"abrasion",
+	"abrasive",
+	"abruptly",
+	"abscissa",
+	"absentee",
+	"absently",
+	"absolute",
+	"absorbed",
+	"abstains",
+	"abstract",
+	"abundant",
+	"academia",
+	"academic",
+	"accepted",
+	"accident",
+	"accolade",
+	"accosted",
+	"accredit",
+	"accuracy",
+	"accurate",
+	"accustom",
+	"acerbity",
+	"achieved",
+	"achiever",
+	"acoustic",
+	"acquaint",
+	"acquired",
+	"acrobats",
+	"actively",
+	"activity",
+	"actually",
+	"actuator",
+	"adaptive",
+	"addicted",
+	"addition",
+	"additive",
+	"adequate",
+	"adhesion",
+	"adhesive",
+	"adjacent",
+	"adjusted",
+	"adjuster",
+	"admiring",
+	"admitted",
+	"adoption",
+	"adorable",
+	"adorning",
+	"adroitly",
+	"adultery",
+	"advanced",
+	"advisory",
+	"advocate",
+	"aesthete",
+	"affected",
+	"affinity",
+	"affluent",
+	"agencies",
+	"aggrieve",
+	"agitated",
+	"agnostic",
+	"agreeing",
+	"airborne",
+	"aircraft",
+	"airfield",
+	"airplane",
+	"airspace",
+	"alarming",
+	"alchemic",
+	"alienate",
+	"allergic",
+	"alleyway",
+	"alliance",
+	"allocate",
+	"allotted",
+	"allowing",
+	"allusion",
+	"almighty",
+	"alphabet",
+	"although",
+	"altitude",
+	"aluminum",
+	"amateurs",
+	"ambition",
+	"amenable",
+	"amethyst",
+	"amortize",
+	"amputate",
+	"amusedly",
+	"analysis",
+	"ancestor",
+	"anchored",
+	"anecdote",
+	"angelica",
+	"animated",
+	"annotate",
+	"announce",
+	"annually",
+	"anointed",
+	"anteater",
+	"antelope",
+	"anterior",
+	"anthills",
+	"antibody",
+	"antidote",
+	"antihero",
+	"antipode",
+	"antiques",
+	"antlered",
+	"anything",
+	"anywhere",
+	"apostate",
+	"apparent",
+	"appendix",
+	"appetite",
+	"applause",
+	"applying",
+	"appraise",
+	"approach",
+	"approval",
+	"approved",
+	"aptitude",
+	"aquarium",
+	"aqueduct",
+	"arbitral",
+	"arboreal",
+	"archaism",
+	"archives",
+	"ardently",
+	"argument",
+	"armchair",
+	"armoured",
+	"aromatic",
+	"arranged",
+	"arrogant",
+	"artefact",
+	"artifact",
+	"artistic",
+	"artistry",
+	"asbestos",
+	"ascended",
+	"ascetics",
+	"ashtrays",
+	"asphyxia",
+	"aspirate",
+	"assailed",
+	"assassin",
+	"assembly",
+	"assorted",
+	"assuming",
+	"astutely",
+	"atheists",
+	"athletic",
+	"attached",
+	"attacker",
+	"attained",
+	"attempts",
+	"attender",
+	"attested",
+	"attitude",
+	"attorney",
+	"audacity",
+	"audience",
+	"audition",
+	"autonomy",
+	"aversion",
+	"aviation",
+	"avoiding",
+	"awakened",
+	"babbling",
+	"bachelor",
+	"backache",
+	"backbone",
+	"backfire",
+	"backpack",
+	"backward",
+	"backyard",
+	"bacteria",
+	"bailiffs",
+	"balanced",
+	"baldness",
+	"ballroom",
+	"balsamic",
+	"bandaged",
+	"banished",
+	"bankrupt",
+	"banquets",
+	"baptized",
+	"barbecue",
+	"barefoot",
+	"bargains",
+	"baritone",
+	"barnacle",
+	"barnyard",
+	"baroness",
+	"barracks",
+	"barrette",
+	"bartered",
+	"baseball",
+	"basement",
+	"basilica",
+	"bassinet",
+	"bathroom",
+	"battered",
+	"battling",
+	"bayonets",
+	"beaching",
+	"bearable",
+	"beautify",
+	"becoming",
+	"bedazzle",
+	"bedstead",
+	"beefcake",
+	"befriend",
+	"begrudge",
+	"behavior",
+	"beholden",
+	"believer",
+	"bellbird",
+	"bellhops",
+	"belonged",
+	"bemoaned",
+	"benefits",
+	"benignly",
+	"bequeath",
+	"berating",
+	"besieged",
+	"bestowal",
+	"bestrode",
+	"betrayal",
+	"beverage",
+	"bewailed",
+	"biannual",
+	"bickered",
+	"bicycles",
+	"bigamist",
+	"billfold",
+	"billions",
+	"binaural",
+	"birdbath",
+	"birdcage",
+	"birdsong",
+	"birthday",
+	"biscuits",
+	"bisected",
+	"blackout",
+	"blandish",
+	"blankets",
+	"blasting",
+	"blazoned",
+	"bleached",
+	"bleeding",
+	"blenders",
+	"blessing",
+	"blinding",
+	"blinkers",
+	"blissful",
+	"blizzard",
+	"blockade",
+	"blooming",
+	"blotched",
+	"blowfish",
+	"bludgeon",
+	"bluebell",
+	"bluebird",
+	"blushing",
+	"blustery",
+	"boarders",
+	"boastful",
+	"bobolink",
+	"bodywork",
+	"boldface",
+	"bonehead",
+	"bookcase",
+	"bookworm",
+	"bootlace",
+	"borrowed",
+	"botanist",
+	"bothered",
+	"bouffant",
+	"bouncing",
+	"boundary",
+	"bouquets",
+	"boutique",
+	"bracelet",
+	"brackets",
+	"bragging",
+	"braiding",
+	"brambles",
+	"brandish",
+	"bravados",
+	"breakage",
+	"breakers",
+	"breaking",
+	"breakout",
+	"breathed",
+	"breeches",
+	"breezily",
+	"brickbat",
+	"bridging",
+	"briefing",
+	"brightly",
+	"brimming",
+	"bristles",
+	"broccoli",
+	"brochure",
+	"brooding",
+	"brownies",
+	"browsing",
+	"brunette",
+	"brutally",
+	"bubbling",
+	"buckshot",
+	"buckskin",
+	"building",
+	"bulkhead",
+	"bulletin",
+	"bullfrog",
+	"bullhorn",
+	"bungalow",
+	"buoyancy",
+	"burglary",
+	"burgundy",
+	"bursting",
+	"bushfire",
+	"business",
+	"butchery",
+	"buttered",
+	"buttocks",
+	"buzzards",
+	"cabinets",
+	"cableway",
+	"caffeine",
+	"calamity",
+	"calculus",
+	"calendar",
+	"callused",
+	"calmness",
+	"calories",
+	"camisole",
+	"campaign",
+	"campfire",
+	"campsite",
+	"canaries",
+	"cannibal",
+	"canoeist",
+	"canopies",
+	"capacity",
+	"capering",
+	"capstone",
+	"captains",
+	"captious",
+	"captured",
+	"carapace",
+	"carbonic",
+	"cardigan",
+	"cardinal",
+	"careened",
+	"carefree",
+	"careless",
+	"carnival",
+	"carousel",
+	"carriage",
+	"carryall",
+	"cartload",
+	"cascaded",
+	"casement",
+	"cashmere",
+	"cassette",
+	"castaway",
+	"casualty",
+	"catacomb",
+	"catalyst",
+	"catapult",
+	"catchall",
+	"category",
+	"cavalier",
+	"cavities",
+	"celibate",
+	"cellular",
+	"cemetery",
+	"centered",
+	"ceremony",
+	"chairman",
+	"champion",
+	"chaplain",
+	"charcoal",
+	"charisma",
+	"chastise",
+	"checkers",
+	"cheerful",
+	"chemical",
+	"cherubic",
+	"chestnut",
+	"children",
+	"chipmunk",
+	"chitchat",
+	"chivalry",
+	"chlorine",
+	"chuckled",
+	"churlish",
+	"cinnamon",
+	"circling",
+	"circular",
+	"citation",
+	"civilian",
+	"claimant",
+	"clambake",
+	"clamored",
+	"clarinet",
+	"classify",
+	"claymore",
+	"cleansed",
+	"clearing",
+	"clematis",
+	"climatic",
+	"clincher",
+	"clinging",
+	"clinical",
+	"clipping",
+	"cloister",
+	"clothier",
+	"clothing",
+	"cloudlet",
+	"clubbing",
+	"cockatoo",
+	"cockerel",
+	"coherent",
+	"coiffure",
+	"coincide",
+	"collapse",
+	"collided",
+	"colonial",
+	"colonist",
+	"colorful",
+	"colossal",
+	"columnar",
+	"comeback",
+	"commando",
+	"commence",
+	"commerce",
+	"commonly",
+	"compadre",
+	"complain",
+	"complete",
+	"complied",
+	"composed",
+	"composer",
+	"compound",
+	"compress",
+	"comprise",
+	"computer",
+	"conceded",
+	"conceive",
+	"concerto",
+	"conclave",
+	"conclude",
+	"concrete",
+	"condense",
+	"confetti",
+	"conflate",
+	"conflict",
+	"confound",
+	"confused",
+	"congrats",
+	"congress",
+	"conjugal",
+	"conquest",
+	"conserve",
+	"consider",
+	"consoled",
+	"conspire",
+	"constant",
+	"consular",
+	"consumer",
+	"contempt",
+	"continue",
+	"contract",
+	"contrary",
+	"contrast",
+	"contrite",
+	"convened",
+	"converse",
+	"convexly",
+	"convince",
+	"cookbook",
+	"coolness",
+	"copperas",
+	"cordless",
+	"corkwood",
+	"cornmeal",
+	"corporal",
+	"corridor",
+	"corroded",
+	"corsages",
+	"cosiness",
+	"costumed",
+	"cottages",
+	"councils",
+	"countess",
+	"coupling",
+	"courtesy",
+	"courtier",
+	"covenant",
+	"coverage",
+	"covering",
+	"coverlet",
+	"coveting",
+	"cowardly",
+	"cowering",
+	"coxswain",
+	"crackers",
+	"cradling",
+	"craftily",
+	"crannies",
+	"crawfish",
+	"crawling",
+	"creakily",
+	"creamery",
+	"creation",
+	"creative",
+	"credible",
+	"crescent",
+	"crevasse",
+	"criminal",
+	"crinkled",
+	"crippled",
+	"critical",
+	"crockery",
+	"crossbow",
+	"crossing",
+	"crowbars",
+	"crucible",
+	"crumbled",
+	"crushing",
+	"crystals",
+	"cuddling",
+	"culinary",
+	"culpable",
+	"cultural",
+	"cultured",
+	"cupboard",
+	"cupidity",
+	"curative",
+	"currants",
+	"currency",
+	"curtains",
+	"cushions",
+	"customer",
+	"cuteness",
+	"cyclical",
+	"cylinder",
+	"cynicism",
+	"dabbling",
+	"daffodil",
+	"dairymen",
+	"dandruff",
+	"danseuse",
+	"daringly",
+	"darkness",
+	"darkroom",
+	"database",
+	"dateline",
+	"daughter",
+	"daunting",
+	"daylight",
+	"dazzling",
+	"deadbolt",
+	"deadline",
+	"deadlock",
+	"deafened",
+	"dearness",
+	"debonair",
+	"debunked",
+	"decanter",
+	"deceased",
+	"deceived",
+	"decently",
+	"deciding",
+	"decipher",
+	"decision",
+	"decisive",
+	"deckhand",
+	"declared",
+	"decorate",
+	"decrease",
+	"decrepit",
+	"dedicate",
+	"defender",
+	"deferral",
+	"defiance",
+	"definite",
+	"deflated",
+	"deformed",
+	"defrayed",
+	"deftness",
+	"delegate",
+	"delicacy",
+	"delicate",
+	"delirium",
+	"delivery",
+	"deluding",
+	"demeanor",
+	"demolish",
+	"denounce",
+	"depicted",
+	"deplored",
+	"deported",
+	"deprived",
+	"derelict",
+	"describe",
+	"designer",
+	"desolate",
+	"despatch",
+	"destined",
+	"detached",
+	"detailed",
+	"detector",
+	"deterred",
+	"dethrone",
+	"detonate",
+	"devilish",
+	"devotion",
+	"devoured",
+	"dewberry",
+	"dextrous",
+	"diabetes",
+	"diagonal",
+	"dialogue",
+	"dialysis",
+	"diameter",
+	"diatribe",
+	"dictator",
+	"diffused",
+	"digested",
+	"dilation",
+	"diligent",
+	"dinosaur",
+	"diplomat",
+	"directed",
+	"directly",
+	"director",
+	"disabled",
+	"disallow",
+	"disarray",
+	"disaster",
+	"disburse",
+	"discount",
+	"discover",
+	"discreet",
+	"discrete",
+	"disdains",
+	"disgrace",
+	"disguise",
+	"disjoint",
+	"disliked",
+	"dismount",
+	"disorder",
+	"dispatch",
+	"dispense",
+	"disperse",
+	"displace",
+	"disposal",
+	"disquiet",
+	"dissolve",
+	"distance",
+	"distaste",
+	"distinct",
+	"distract",
+	"distress",
+	"district",
+	"dividend",
+	"dividers",
+	"divinely",
+	"division",
+	"docility",
+	"doctrine",
+	"document",
+	"doggedly",
+	"doghouse",
+	"doldrums",
+	"dolphins",
+	"domestic",
+	"domicile",
+	"dominant",
+	"donation",
+	"doorbell",
+	"doorknob",
+	"doormats",
+	"doorstep",
+	"dopamine",
+	"dormancy",
+	"dovetail",
+	"downbeat",
+	"downcast",
+	"downfall",
+	"downhill",
+	"downpour",
+	"downtown",
+	"doxology",
+	"dragging",
+	"dragnets",
+	"dragoons",
+	"drainage",
+	"dramatic",
+	"drawback",
+	"drawling",
+	"dreadful",
+	"dreamily",
+	"dressage",
+	"dressing",
+	"drifting",
+	"drilling",
+	"drinking",
+	"drizzled",
+	"drowning",
+	"drowsily",
+	"drudgery",
+	"drumbeat",
+	"drumming",
+	"duckling",
+	"dumbbell",
+	"dumpling",
+	"dungeons",
+	"duplexes",
+	"durables",
+	"duration",
+	"dutiable",
+	"dwelling",
+	"dwindled",
+	"dynamite",
+	"earliest",
+	"earmuffs",
+	"earnests",
+	"earnings",
+	"earphone",
+	"easement",
+	"eclectic",
+	"economic",
+	"ecstatic",
+	"educated",
+	"educator",
+	"eggplant",
+	"eggshell",
+	"eighteen",
+	"ejection",
+	"elapsing",
+	"elastics",
+	"elbowing",
+	"election",
+	"electors",
+	"electric",
+	"elegance",
+	"elephant",
+	"elevated",
+	"elevator",
+	"eligible",
+	"elliptic",
+	"eloquent",
+	"emaciate",
+	"embalmed",
+	"embattle",
+	"embezzle",
+	"embitter",
+	"emblazon",
+	"embodied",
+	"emergent",
+	"emerging",
+	"emigrant",
+	"eminence",
+	"emissary",
+	"emission",
+	"emotions",
+	"empathic",
+	"emperors",
+	"emphasis",
+	"emphatic",
+	"employed",
+	"employee",
+	"employer",
+	"emporium",
+	"empowers",
+	"enabling",
+	"enacting",
+	"enamored",
+	"enchants",
+	"enclosed",
+	"encroach",
+	"endanger",
+	"endeared",
+	"endeavor",
+	"enduring",
+	"energize",
+	"enforced",
+	"engaging",
+	"engineer",
+	"engorged",
+	"engraved",
+	"enjoying",
+	"enormity",
+	"enormous",
+	"enquired",
+	"enriched",
+	"ensemble",
+	"enshrine",
+	"entangle",
+	"enticing",
+	"entirely",
+	"entirety",
+	"entitled",
+	"entrails",
+	"entrance",
+	"entrench",
+	"entwined",
+	"envelope",
+	"envisage",
+	"envision",
+	"epidemic",
+	"epilogue",
+	"equality",
+	"equalize",
+	"equation",
+	"equipped",
+	"erection",
+	"eruption",
+	"escalate",
+	"escapade",
+	"escorted",
+	"esoteric",
+	"espousal",
+	"essayist",
+	"esteemed",
+	"estimate",
+	"eternity",
+	"ethereal",
+	"evacuate",
+	"evaluate",
+	"evenness",
+	"eventful",
+	"eventual",
+	"evermore",
+	"everyday",
+	"everyone",
+	"evicting",
+	"evidence",
+	"evildoer",
+	"examined",
+	"exceeded",
+	"excerpts",
+	"exchange",
+	"exciting",
+	"excluded",
+	"excursus",
+	"executed",
+	"exercise",
+	"exertion",
+	"exhaling",
+	"exhorted",
+	"expanded",
+	"expected",
+	"expedite",
+	"expelled",
+	"expended",
+	"explicit",
+	"exploded",
+	"exported",
+	"exposure",
+	"extended",
+	"external",
+	"extolled",
+	"extruded",
+	"exultant",
+	"eyeglass",
+	"eyeliner",
+	"eyesight",
+	"fabulous",
+	"faceless",
+	"facility",
+	"factious",
+	"faithful",
+	"falconry",
+	"familiar",
+	"fanciful",
+	"farewell",
+	"farmhand",
+	"farmland",
+	"farthest",
+	"fastened",
+	"fatherly",
+	"faultily",
+	"favorite",
+	"fearless",
+	"feasible",
+	"feedback",
+	"feigning",
+	"feminine",
+	"ferocity",
+	"ferryman",
+	"festival",
+	"fetching",
+	"feverish",
+	"fiddling",
+	"fidelity",
+	"fiercely",
+	"fighting",
+	"filigree",
+	"finalist",
+	"fineness",
+	"finished",
+	"fireball",
+	"firebird",
+	"fireside",
+	"firewall",
+	"fishbowl",
+	"fishhook",
+	"flagpole",
+	"flagrant",
+	"flamingo",
+	"flapjack",
+	"flashily",
+	"flattery",
+	"flawless",
+	"fleeting",
+	"flexible",
+	"flimsily",
+	"flinched",
+	"flippant",
+	"floating",
+	"flounder",
+	"flourish",
+	"fluently",
+	"fluorine",
+	"flurries",
+	"flywheel",
+	"focusing",
+	"foldable",
+	"folklore",
+	"follicle",
+	"fondness",
+	"football",
+	"foothill",
+	"footnote",
+	"footpath",
+	"footstep",
+	"forceful",
+	"forebear",
+	"forecast",
+	"forefoot",
+	"forehead",
+	"foremost",
+	"forensic",
+	"forestry",
+	"forewarn",
+	"forgiven",
+	"formally",
+	"formerly",
+	"fortieth",
+	"fortress",
+	"fourteen",
+	"fraction",
+	"fracture",
+	"fragment",
+	"fragrant",
+	"freckled",
+	"freehand",
+	"freezing",
+	"frenetic",
+	"frequent",
+	"freshman",
+	"friction",
+	"friendly",
+	"frighten",
+	"frontage",
+	"frontier",
+	"frugally",
+	"fruitful",
+	"fugitive",
+	"fumbling",
+	"function",
+	"funnyman",
+	"furlough",
+	"furthest",
+	"futurism",
+	"gadabout",
+	"galloped",
+	"gambling",
+	"gangster",
+	"garbanzo",
+	"gardener",
+	"garrison",
+	"gasoline",
+	"gauntlet",
+	"gemstone",
+	"generate",
+	"generous",
+	"genially",
+	"genocide",
+	"geometry",
+	"gestures",
+	"giggling",
+	"gingerly",
+	"giveaway",
+	"gladness",
+	"glancing",
+	"glassful",
+	"glimmers",
+	"glittery",
+	"gloaming",
+	"globally",
+	"glorious",
+	"glossary",
+	"gobbling",
+	"goldfish",
+	"goodness",
+	"gorgeous",
+	"governor",
+	"graceful",
+	"graduate",
+	"granddad",
+	"grandson",
+	"graphics",
+	"graphite",
+	"grasping",
+	"grateful",
+	"gratuity",
+	"greenery",
+	"grievous",
+	"grimness",
+	"gripping",
+	"gritting",
+	"grizzled",
+	"groaning",
+	"grounded",
+	"grouping",
+	"grudging",
+	"gruesome",
+	"grumbled",
+	"guardian",
+	"guessing",
+	"guidance",
+	"gullible",
+	"gumption",
+	"gymnasts",
+	"habitual",
+	"hairless",
+	"halfback",
+	"hallmark",
+	"hallowed",
+	"handball",
+	"handcuff",
+	"handling",
+	"handmade",
+	"handsome",
+	"handyman",
+	"hangover",
+	"happened",
+	"hardship",
+	"hardware",
+	"harmless",
+	"harmonic",
+	"harshest",
+	"hatchery",
+	"haunting",
+	"hawthorn",
+	"haystack",
+	"hazelnut",
+	"headache",
+	"headband",
+	"headlamp",
+	"headline",
+	"headlong",
+	"headroom",
+	"headwind",
+	"heartily",
+	"heathens",
+	"heavenly",
+	"hedgehog",
+	"heedless",
+	"heirloom",
+	"helpless",
+	"herbaria",
+	"hereupon",
+	"heritage",
+	"hermetic",
+	"hesitant",
+	"hesitate",
+	"hiccough",
+	"hideaway",
+	"highland",
+	"hijacked",
+	"hilarity",
+	"hindmost",
+	"historic",
+	"hitherto",
+	"hobbyist",
+	"hogshead",
+	"holidays",
+	"hologram",
+	"homeland",
+	"homeless",
+	"homemade",
+	"homespun",
+	"homework",
+	"honeybee",
+	"hoodwink",
+	"hooligan",
+	"hopeless",
+	"horrible",
+	"horsefly",
+	"hospital",
+	"hostages",
+	"hotelier",
+	"humanity",
+	"humidity",
+	"humility",
+	"humorist",
+	"hundreds",
+	"huntsman",
+	"hurdling",
+	"hurtling",
+	"hydrogen",
+	"hygienic",
+	"hypnosis",
+	"hysteria",
+	"iceboxes",
+	"idealism",
+	"identify",
+	"identity",
+	"ideology",
+	"idolatry",
+	"ignition",
+	"ignorant",
+	"illusion",
+	"imitated",
+	"immature",
+	"immobile",
+	"immodest",
+	"immortal",
+	"impaired",
+	"impeding",
+	"imperial",
+	"implicit",
+	"imposing",
+	"impostor",
+	"impotent",
+	"improper",
+	"impudent",
+	"inaction",
+	"inasmuch",
+	"incensed",
+	"incident",
+	"incisive",
+	"inclined",
+	"included",
+	"incoming",
+	"increase",
+	"indebted",
+	"indecent",
+	"indented",
+	"indicate",
+	"indigent",
+	"indirect",
+	"indolent",
+	"inductee",
+	"industry",
+	"inedible",
+	"inequity",
+	"inexpert",
+	"infantry",
+	"infinite",
+	"informal",
+	"informed",
+	"infringe",
+	"inhaling",
+	"inherent",
+	"initials",
+	"initiate",
+	"injected",
+	"inkblots",
+	"innocent",
+	"innovate",
+	"insanely",
+	"inscribe",
+	"insecure",
+	"insignia",
+	"insisted",
+	"insolent",
+	"insomnia",
+	"inspired",
+	"instance",
+	"instinct",
+	"insulate",
+	"intaglio",
+	"integral",
+	"intended",
+	"intently",
+	"interact",
+	"interest",
+	"interior",
+	"internal",
+	"interred",
+	"interval",
+	"intimate",
+	"intrepid",
+	"intrigue",
+	"inundate",
+	"invasion",
+	"inventor",
+	"investor",
+	"inviting",
+	"involved",
+	"ironclad",
+	"ironware",
+	"irrigate",
+	"irritant",
+	"isolated",
+	"itemized",
+	"jackpots",
+	"jalousie",
+	"jamboree",
+	"japanned",
+	"jeopardy",
+	"jettison",
+	"jewelers",
+	"jingling",
+	"jodhpurs",
+	"jokingly",
+	"jostling",
+	"jubilant",
+	"judgment",
+	"judicial",
+	"juggling",
+	"junction",
+	"juvenile",
+	"kangaroo",
+	"keepsake",
+	"kerchief",
+	"keyboard",
+	"keystone",
+	"kickback",
+	"kindling",
+	"kindness",
+	"kingship",
+	"kinsfolk",
+	"knapsack",
+	"kneecaps",
+	"knickers",
+	"knitting",
+	"knockout",
+	"knothole",
+	"labelled",
+	"laboured",
+	"lacrosse",
+	"ladylike",
+	"lambskin",
+	"lameness",
+	"lamented",
+	"landfall",
+	"landlord",
+	"landmark",
+	"landmass",
+	"language",
+	"languish",
+	"lapidary",
+	"largesse",
+	"laughter",
+	"lavender",
+	"lavishly",
+	"lawmaker",
+	"laziness",
+	"leapfrog",
+	"learning",
+	"leathery",
+	"leftover",
+	"legation",
+	"leggings",
+	"lemonade",
+	"leniency",
+	"leopards",
+	"lethargy",
+	"lettered",
+	"levelled",
+	"leverage",
+	"lexicons",
+	"liberate",
+	"licorice",
+	"lifeboat",
+	"lifeless",
+	"lifelike",
+	"lifelong",
+	"lifetime",
+	"ligament",
+	"lighting",
+	"likeness",
+	"likewise",
+	"limerick",
+	"limiting",
+	"linguist",
+	"lipstick",
+	"listened",
+	"literacy",
+	"literary",
+	"litigate",
+	"littoral",
+	"lobbying",
+	"localize",
+	"location",
+	"lockable",
+	"lodestar",
+	"loftiest",
+	"lonesome",
+	"longhand",
+	"loophole",
+	"lopsided",
+	"lordship",
+	"loveless",
+	"lovingly",
+	"luckless",
+	"lukewarm",
+	"luminous",
+	"lunchbox",
+	"luscious",
+	"lustrous",
+	"magazine",
+	"magician",
+	"magnetic",
+	"magnolia",
+	"mahogany",
+	"mainland",
+	"maintain",
+	"majestic",
+	"majority",
+	"makeover",
+	"malarial",
+	"malinger",
+	"mandolin",
+	"maneuver",
+	"manifest",
+	"mannered",
+	"marathon",
+	"marginal",
+	"marigold",
+	"maritime",
+	"marksman",
+	"marquess",
+	"marriage",
+	"marzipan",
+	"massacre",
+	"mastodon",
+	"matchbox",
+	"material",
+	"maturity",
+	"maverick",
+	"maximize",
+	"meanness",
+	"meantime",
+	"measured",
+	"medicine",
+	"medieval",
+	"mediocre",
+	"meekness",
+	"melodies",
+	"membrane",
+	"memorial",
+	"memorize",
+	"menacing",
+	"merchant",
+	"mesmeric",
+	"messiest",
+	"metaphor",
+	"meteoric",
+	"midnight",
+	"midpoint",
+	"midsized",
+	"midwives",
+	"migraine",
+	"milepost",
+	"militant",
+	"military",
+	"milkmaid",
+	"millrace",
+	"minimize",
+	"minister",
+	"ministry",
+	"minority",
+	"minstrel",
+	"minutely",
+	"mirthful",
+	"misapply",
+	"mischief",
+	"misdoing",
+	"miserere",
+	"misjudge",
+	"misnomer",
+	"misprint",
+	"misspell",
+	"mistaken",
+	"mistress",
+	"mobility",
+	"mobilize",
+	"moccasin",
+	"moderate",
+	"modestly",
+	"moisture",
+	"molasses",
+	"molecule",
+	"momentum",
+	"monarchy",
+	"monetary",
+	"monogram",
+	"monopoly",
+	"monotone",
+	"moonbeam",
+	"moonshot",
+	"moralist",
+	"morality",
+	"morosely",
+	"mortgage",
+	"mosquito",
+	"motherly",
+	"motivate",
+	"mountain",
+	"mournful",
+	"mouthful",
+	"movement",
+	"muckrake",
+	"muddling",
+	"mudguard",
+	"muffling",
+	"mulberry",
+	"multiple",
+	"munition",
+	"murderer",
+	"muscular",
+	"mushroom",
+	"musician",
+	"mutation",
+	"mutineer",
+	"mutinous",
+	"mutually",
+	"mystical",
+	"nameless",
+	"namesake",
+	"narrated",
+	"narrower",
+	"national",
+	"nauseous",
+	"navigate",
+	"nearness",
+	"neatness",
+	"necklace",
+	"neckline",
+	"needless",
+	"negation",
+	"negative",
+	"neighbor",
+	"nestling",
+	"nicotine",
+	"nightcap",
+	"nineteen",
+	"nobility",
+	"nocturne",
+	"nominate",
+	"nonsense",
+	"normally",
+	"nosedive",
+	"notebook",
+	"novelist",
+	"numbered",
+	"numbness",
+	"numerous",
+	"nutrient",
+	"nuzzling",
+	"obdurate",
+	"obedient",
+	"obituary",
+	"obligate",
+	"oblivion",
+	"observed",
+	"observer",
+	"obsolete",
+	"obstacle",
+	"obstruct",
+	"occasion",
+	"occupant",
+	"occupied",
+	"offering",
+	"official",
+	"offshoot",
+	"offshore",
+	"oilfield",
+	"ointment",
+	"oleander",
+	"omission",
+	"oncoming",
+	"onlooker",
+	"openness",
+	"operator",
+	"opponent",
+	"opposing",
+	"opposite",
+	"optician",
+	"optimism",
+	"optional",
+	"opulence",
+	"oracular",
+	"orations",
+	"orbiting",
+	"ordained",
+	"ordinary",
+	"organism",
+	"organize",
+	"oriented",
+	"original",
+	"ornament",
+	"orphaned",
+	"ossified",
+	"outboard",
+	"outbound",
+	"outbreak",
+	"outburst",
+	"outdoors",
+	"outfield",
+	"outflank",
+	"outgoing",
+	"outhouse",
+	"outlying",
+	"outraged",
+	"outrider",
+	"outright",
+	"outshine",
+	"outsider",
+	"outsmart",
+	"outstrip",
+	"overalls",
+	"overcast",
+	"overcoat",
+	"overcome",
+	"overdone",
+	"overdose",
+	"overflow",
+	"overhang",
+	"overhaul",
+	"overhead",
+	"overland",
+	"overload",
+	"overlook",
+	"overlord",
+	"overpass",
+	"overrule",
+	"overseas",
+	"overseer",
+	"overshoe",
+	"overtake",
+	"overtime",
+	"overture",
+	"overturn",
+	"overview",
+	"overwork",
+	"oxidized",
+	"pacifier",
+	"paddling",
+	"padlocks",
+	"painless",
+	"painting",
+	"palatial",
+	"palisade",
+	"palomino",
+	"pamphlet",
+	"pancakes",
+	"pandemic",
+	"paneling",
+	"panorama",
+	"pantheon",
+	"paradise",
+	"parakeet",
+	"parallel",
+	"paralyze",
+	"parasite",
+	"pardoned",
+	"parental",
+	"parodist",
+	"particle",
+	"partisan",
+	"passable",
+	"passport",
+	"password",
+	"pastiche",
+	"pastoral",
+	"patience",
+	"pavement",
+	"pavilion",
+	"peaceful",
+	"peculiar",
+	"pedantic",
+	"pedigree",
+	"peephole",
+	"peerless",
+	"pelleted",
+	"penchant",
+	"pendulum",
+	"penitent",
+	"penknife",
+	"pennants",
+	"perceive",
+	"perilous",
+	"periodic",
+	"perjured",
+	"permeate",
+	"personal",
+	"persuade",
+	"pertness",
+	"perusing",
+	"petition",
+	"petulant",
+	"phantasm",
+	"pheasant",
+	"phonetic",
+	"physical",
+	"physique",
+	"pickerel",
+	"piddling",
+	"pinafore",
+	"pinnacle",
+	"pinwheel",
+	"pipeline",
+	"pitchers",
+	"pitiable",
+	"pittance",
+	"pizzeria",
+	"placidly",
+	"plankton",
+	"planning",
+	"plantain",
+	"platform",
+	"platinum",
+	"playmate",
+	"playroom",
+	"pleasant",
+	"pleasure",
+	"plebeian",
+	"plethora",
+	"plighted",
+	"ploughed",
+	"plumbing",
+	"plunging",
+	"pocketed",
+	"poignant",
+	"poisoned",
+	"polished",
+	"politely",
+	"politics",
+	"polluted",
+	"poltroon",
+	"ponytail",
+	"populace",
+	"populate",
+	"populous",
+	"portable",
+	"portrait",
+	"position",
+	"positive",
+	"possible",
+	"possibly",
+	"postcard",
+	"postpone",
+	"potbelly",
+	"pothooks",
+	"powdered",
+	"powerful",
+	"practice",
+	"prancing",
+	"preacher",
+	"preamble",
+	"precious",
+	"preclude",
+	"predator",
+	"preening",
+	"pregnant",
+	"prejudge",
+	"premiere",
+	"premises",
+	"prepared",
+	"presence",
+	"preserve",
+	"pressing",
+	"pressure",
+	"prestige",
+	"presumed",
+	"pretense",
+	"prettily",
+	"previous",
+	"priestly",
+	"primeval",
+	"princely",
+	"princess",
+	"printing",
+	"priority",
+	"prisoner",
+	"pristine",
+	"probable",
+	"prodding",
+	"producer",
+	"profound",
+	"progress",
+	"prohibit",
+	"prolific",
+	"prologue",
+	"promised",
+	"promptly",
+	"properly",
+	"property",
+	"prophecy",
+	"prophets",
+	"proposal",
+	"prosodic",
+	"prospect",
+	"protocol",
+	"protract",
+	"protrude",
+	"provided",
+	"provider",
+	"province",
+	"prudence",
+	"publican",
+	"publicly",
+	"puddling",
+	"pugilism",
+	"pumpkins",
+	"punctual",
+	"puncture",
+	"pungency",
+	"punished",
+	"puppetry",
+	"purblind",
+	"purchase",
+	"pureness",
+	"purified",
+	"purplish",
+	"pursuant",
+	"pursuing",
+	"purveyor",
+	"pushover",
+	"puzzling",
+	"quadrant",
+	"quagmire",
+	"quantity",
+	"quarrels",
+	"quarried",
+	"quatrain",
+	"queasily",
+	"question",
+	"quibbled",
+	"quickest",
+	"quietest",
+	"quilting",
+	"quotient",
+	"racquets",
+	"radiance",
+	"radiator",
+	"raftered",
+	"ragweeds",
+	"railroad",
+	"raincoat",
+	"raindrop",
+	"rainfall",
+	"rambling",
+	"rampaged",
+	"ranching",
+	"rapidity",
+	"rapports",
+	"rareness",
+	"rascally",
+	"raspiest",
+	"ratified",
+	"rational",
+	"rattling",
+	"ravenous",
+	"ravished",
+	"reabsorb",
+	"reaction",
+	"readable",
+	"reaffirm",
+	"realized",
+	"reasoned",
+	"reassure",
+	"rebelled",
+	"rebutted",
+	"recanted",
+	"received",
+	"recently",
+	"receptor",
+	"recessed",
+	"reckless",
+	"reckoned",
+	"reclined",
+	"recorded",
+	"recorder",
+	"recovery",
+	"recruits",
+	"redeemer",
+	"redolent",
+	"redshift",
+	"referral",
+	"refinery",
+	"reformer",
+	"refueled",
+	"regaling",
+	"regiment",
+	"regional",
+	"register",
+	"rehearse",
+	"reindeer",
+	"rekindle",
+	"relation",
+	"relative",
+	"relaunch",
+	"relaxing",
+	"relegate",
+	"relevant",
+	"reliable",
+	"reliance",
+	"relieved",
+	"religion",
+	"relished",
+	"remedial",
+	"remember",
+	"reminder",
+	"remotely",
+	"renegade",
+	"renounce",
+	"renovate",
+	"renowned",
+	"repaired",
+	"repartee",
+	"repeated",
+	"repelled",
+	"replayed",
+	"reporter",
+	"reprieve",
+	"reprisal",
+	"reproach",
+	"republic",
+	"required",
+	"research",
+	"resemble",
+	"reserved",
+	"resident",
+	"residual",
+	"resigned",
+	"resinous",
+	"resolute",
+	"resonant",
+	"resource",
+	"respects",
+	"response",
+	"restless",
+	"restored",
+	"restrain",
+	"restrict",
+	"retailer",
+	"retiring",
+	"retrieve",
+	"reunited",
+	"revealed",
+	"reverend",
+	"reversal",
+	"revision",
+	"rheostat",
+	"rhetoric",
+	"rhythmic",
+	"ribaldry",
+	"richness",
+	"ricochet",
+	"riddance",
+	"ridicule",
+	"rightful",
+	"rigorous",
+	"ringside",
+	"rivalled",
+	"riverbed",
+	"roadside",
+	"roasting",
+	"robotics",
+	"romantic",
+	"rooftops",
+	"rosebush",
+	"rotation",
+	"roughage",
+	"roulette",
+	"rousting",
+	"rucksack",
+	"rudiment",
+	"ruefully",
+	"ruggedly",
+	"ruminate",
+	"runabout",
+	"runnable",
+	"rustling",
+	"ruthless",
+	"sabotage",
+	"sacristy",
+	"saddened",
+	"safeness",
+	"sailboat",
+	"sailfish",
+	"salaried",
+	"salesman",
+	"saltiest",
+	"salutary",
+	"sanctity",
+	"sandbank",
+	"sandwich",
+	"sapphire",
+	"sardonic",
+	"sashayed",
+	"satchels",
+	"satirist",
+	"saturate",
+	"saucepan",
+	"saunters",
+	"savagely",
+	"sawhorse",
+	"scabbard",
+	"scaffold",
+	"scalding",
+	"scampers",
+	"scandals",
+	"scarcity",
+	"scathing",
+	"scavenge",
+	"scenario",
+	"sceptics",
+	"schedule",
+	"schemata",
+	"schooner",
+	"sciatica",
+	"scissors",
+	"scolding",
+	"scorched",
+	"scornful",
+	"scorpion",
+	"scourged",
+	"scramble",
+	"scrapped",
+	"scratchy",
+	"screamed",
+	"screened",
+	"scribble",
+	"scrubbed",
+	"scrutiny",
+	"sculptor",
+	"scurried",
+	"seabirds",
+	"seafarer",
+	"seahorse",
+	"seashell",
+	"seashore",
+	"seasonal",
+	"seawater",
+	"secluded",
+	"secretly",
+	"security",
+	"sedately",
+	"sedition",
+	"seedless",
+	"seething",
+	"selected",
+	"selector",
+	"selfless",
+	"semester",
+	"semitone",
+	"sensible",
+	"sentence",
+	"sentinel",
+	"separate",
+	"sequence",
+	"serenade",
+	"serenity",
+	"sergeant",
+	"severely",
+	"severity",
+	"shabbily",
+	"shadowed",
+	"shambles",
+	"shamrock",
+	"sheepish",
+	"shepherd",
+	"sheriffs",
+	"shimmery",
+	"shingled",
+	"shipmate",
+	"shipyard",
+	"shocking",
+	"shoehorn",
+	"shoelace",
+	"shopping",
+	"shortage",
+	"shortcut",
+	"shoulder",
+	"showcase",
+	"showdown",
+	"showroom",
+	"shrapnel",
+	"shredded",
+	"shrewdly",
+	"shrugged",
+	"shuffled",
+	"shutdown",
+	"sickness",
+	"sideline",
+	"sideshow",
+	"sidestep",
+	"sidewalk",
+	"silenced",
+	"silently",
+	"silkworm",
+	"simplest",
+	"simulate",
+	"sinecure",
+	"singular",
+	"sinister",
+	"sinkhole",
+	"sisterly",
+	"skeletal",
+	"sketched",
+	"skillful",
+	"skirmish",
+	"skylight",
+	"slapdash",
+	"sledding",
+	"sleeping",
+	"slightly",
+	"slippery",
+	"sloppily",
+	"slumbers",
+	"smallpox",
+	"smarting",
+	"smeltery",
+	"smokable",
+	"smoothly",
+	"smuggler",
+	"snapshot",
+	"sneakers",
+	"sniffles",
+	"snobbery",
+	"snowball",
+	"snowdrop",
+	"snowfall",
+	"snuggled",
+	"sobering",
+	"sociable",
+	"softball",
+	"software",
+	"solarium",
+	"solemnly",
+	"solitary",
+	"solitude",
+	"solution",
+	"somberly",
+	"somebody",
+	"somewhat",
+	"songbird",
+	"songster",
+	"sonority",
+	"soothing",
+	"sorcerer",
+	"sorority",
+	"sounding",
+	"southern",
+	"souvenir",
+	"sparkled",
+	"sparrows",
+	"spatters",
+	"speakers",
+	"speaking",
+	"specific",
+	"speckled",
+	"spectral",
+	"spectrum",
+	"speeches",
+	"spellers",
+	"spending",
+	"spinster",
+	"spirited",
+	"spiteful",
+	"splendid",
+	"splinter",
+	"spoonful",
+	"sporting",
+	"sportive",
+	"spotless",
+	"sprinkle",
+	"sprinter",
+	"sprocket",
+	"spurious",
+	"squadron",
+	"squander",
+	"squarely",
+	"squeaked",
+	"squeezed",
+	"squirrel",
+	"stabbing",
+	"stagnant",
+	"stairway",
+	"stallion",
+	"stalwart",
+	"stampede",
+	"standard",
+	"standing",
+	"standoff",
+	"stardust",
+	"starfish",
+	"stargaze",
+	"starkest",
+	"starling",
+	"starting",
+	"statuary",
+	"steadily",
+	"stepping",
+	"sterling",
+	"stickler",
+	"stiffest",
+	"stimulus",
+	"stinging",
+	"stinking",
+	"stirrups",
+	"stockade",
+	"stoicism",
+	"stomping",
+	"stopover",
+	"storeyed",
+	"straggle",
+	"straight",
+	"stranger",
+	"strapped",
+	"strategy",
+	"streamer",
+	"strength",
+	"stricken",
+	"strident",
+	"striking",
+	"stripped",
+	"stronger",
+	"struggle",
+	"stubborn",
+	"studious",
+	"stuffing",
+	"stumbled",
+	"stunning",
+	"stupidly",
+	"sturgeon",
+	"subduing",
+	"subgroup",
+	"sublease",
+	"submerge",
+	"subtitle",
+	"suburban",
+	"suburbia",
+	"succinct",
+	"suddenly",
+	"sufferer",
+	"suffrage",
+	"suggests",
+	"suitable",
+	"sullenly",
+	"summoned",
+	"sunbathe",
+	"sunburnt",
+	"sundries",
+	"sunlight",
+	"sunshade",
+	"sunshine",
+	"superbly",
+	"superior",
+	"supplant",
+	"supplier",
+	"supplies",
+	"supposed",
+	"surfaced",
+	"surmount",
+	"surplice",
+	"surprise",
+	"surround",
+	"survival",
+	"survivor",
+	"suspense",
+	"swaddled",
+	"swampish",
+	"swashing",
+	"sweating",
+	"sweeping",
+	"swimsuit",
+	"swindler",
+	"swirling",
+	"switched",
+	"swiveled",
+	"swooning",
+	"symbolic",
+	"symmetry",
+	"sympathy",
+	"symphony",
+	"syndrome",
+	"tabletop",
+	"tactical",
+	"tactless",
+	"tailgate",
+	"talisman",
+	"tangible",
+	"tapestry",
+	"tarragon",
+	"tasteful",
+	"teaching",
+	"teardrop",
+	"tearless",
+	"teaspoon",
+	"teenager",
+	"teetotal",
+	"telegram",
+	"telltale",
+	"temerity",
+	"template",
+	"temporal",
+	"tempting",
+	"tenacity",
+	"tendency",
+	"tenderly",
+	"tentacle",
+	"terminal",
+	"terrapin",
+	"terrible",
+	"terrific",
+	"thankful",
+	"thatcher",
+	"theology",
+	"therefor",
+	"thespian",
+	"thickset",
+	"thievish",
+	"thimbles",
+	"thinking",
+	"thinness",
+	"thirteen",
+	"thorough",
+	"thousand",
+	"thrashed",
+	"threaded",
+	"threaten",
+	"threnody",
+	"thriller",
+	"thriving",
+	"throttle",
+	"thumbing",
+	"thundery",
+	"ticklish",
+	"tidiness",
+	"timeless",
+	"timorous",
+	"tingling",
+	"tinkered",
+	"tiresome",
+	"titanium",
+	"toboggan",
+	"together",
+	"toiletry",
+	"tolerant",
+	"tomahawk",
+	"tomorrow",
+	"toothily",
+	"topology",
+	"torchlit",
+	"tortilla",
+	"tortoise",
+	"totality",
+	"touchily",
+	"touching",
+	"toughest",
+	"towering",
+	"townsman",
+	"toxicity",
+	"trackage",
+	"tracking",
+	"tranquil",
+	"transept",
+	"transfer",
+	"trapdoor",
+	"trappers",
+	"traveled",
+	"traverse",
+	"travesty",
+	"treasure",
+	"treatise",
+	"trembled",
+	"trenches",
+	"trespass",
+	"triangle",
+	"trickery",
+	"trillion",
+	"trimming",
+	"tripping",
+	"triumphs",
+	"trombone",
+	"tropical",
+	"troubled",
+	"trousers",
+	"truckers",
+	"truffles",
+	"trumpets",
+	"trustful",
+	"truthful",
+	"tumbling",
+	"tunneled",
+	"turmeric",
+	"turncoat",
+	"turnover",
+	"turnpike",
+	"tweezers",
+	"twilight",
+	"twinkles",
+	"twitched",
+	"ugliness",
+	"ulterior",
+	"ultimate",
+	"umbrella",
+	"unafraid",
+	"unbeaten",
+	"unbiased",
+	"uncaring",
+	"unclothe",
+	"uncommon",
+	"underdog",
+	"underfed",
+	"underlay",
+	"underpay",
+	"undertow",
+	"undulate",
+	"unearned",
+	"uneasily",
+	"unending",
+	"unerring",
+	"unfasten",
+	"unfolded",
+	"unframed",
+	"unharmed",
+	"unicycle",
+	"unionist",
+	"universe",
+	"univocal",
+	"unlawful",
+	"unleaded",
+	"unlikely",
+	"unlocked",
+	"unmarked",
+	"unmasked",
+	"unpacked",
+	"unplaced",
+	"unranked",
+	"unsavory",
+	"unsettle",
+	"unsigned",
+	"unspoken",
+	"unstable",
+	"untangle",
+	"untidily",
+	"unwanted",
+	"unwashed",
+	"unworthy",
+	"upcoming",
+	"updating",
+	"upheaval",
+	"upholder",
+	"uprising",
+	"uprooted",
+	"upstairs",
+	"upstream",
+	"upturned",
+	"urgently",
+	"usurping",
+	"vacation",
+	"vagabond",
+	"validity",
+	"valorous",
+	"valuable",
+	"vanguard",
+	"vanished",
+	"vaporize",
+	"variable",
+	"vastness",
+	"vehement",
+	"velocity",
+	"vendetta",
+	"venomous",
+	"verbally",
+	"verbatim",
+	"vertical",
+	"vesicles",
+	"vestment",
+	"vexation",
+	"vibrated",
+	"vicinity",
+	"viewless",
+	"vigilant",
+	"vigorous",
+	"villager",
+	"vinegary",
+	"vineyard",
+	"violator",
+	"violence",
+	"virtuoso",
+	"virulent",
+	"visceral",
+	"visiting",
+	"vitality",
+	"vitamins",
+	"vivacity",
+	"vocalist",
+	"volatile",
+	"volcanic",
+	"volition",
+	"voracity",
+	"vouchers",
+	"wagering",
+	"walkaway",
+	"wallaroo",
+	"wallowed",
+	"wanderer",
+	"wardrobe",
+	"warmness",
+	"warplane",
+	"warranty",
+	"washable",
+	"wasteful",
+	"watchdog",
+	"watchful",
+	"waterbed",
+	"waterway",
+	"waxworks",
+	"weakened",
+	"weakness",
+	"weaponry",
+	"wearable",
+	"weekends",
+	"weeklong",
+	"weighted",
+	"welcomed",
+	"wellness",
+	"werewolf",
+	"westward",
+	"whacking",
+	"whatever",
+	"wheedled",
+	"whenever",
+	"wherever",
+	"whimsies",
+	"whinnied",
+	"whipcord",
+	"whirling",
+	"whiskery",
+	"whistled",
+	"wickedly",
+	"wideness",
+	"wildfire",
+	"wildlife",
+	"wildness",
+	"wildwood",
+	"windfall",
+	"windmill",
+	"windpipe",
+	"windsurf",
+	"wingspan",
+	"winnings",
+	"wintered",
+	"wireless",
+	"wishbone",
+	"wistaria",
+	"witchery",
+	"withdraw",
+	"withered",
+	"withheld",
+	"wizardry",
+	"woefully",
+	"womanish",
+	"wondrous",
+	"woodbine",
+	"woodcock",
+	"woodland",
+	"woodwork",
+	"workable",
+	"workbook",
+	"workroom",
+	"workshop",
+	"worrying",
+	"worthily",
+	"wrangler",
+	"wrapping",
+	"wreckage",
+	"wrestled",
+	"wretched",
+	"wriggled",
+	"wrinkled",
+	"wristlet",
+	"yachting",
+	"yardbird",
+	"yearling",
+	"yearning",
+	"yodeling",
+	"yourself",
+	"yuletide",
+	"zealotry",
+	"zeppelin",
+	"zucchini",
+}
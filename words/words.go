@@ -1,20 +1,93 @@
 package words
 
 import (
+	"fmt"
 	"math/rand"
 	"time"
 )
 
+const MinLength = 4
+const MaxLength = 8
+const DefaultLength = 5
+
 // create & seed generator
 var r = rand.New(rand.NewSource(time.Now().UnixNano()))
 
-func RandomWord() (string, error) {
-	index := r.Intn(len(words))
-	return words[index], nil
+// date of the first daily puzzle, which uses the first word of each answer list
+var dailyEpoch = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// possible answers and sorted valid guesses for each supported word length
+var answerLists = map[int][]string{4: words4, 5: words, 6: words6, 7: words7, 8: words8}
+var validLists = map[int][]string{4: valid4, 5: valid, 6: valid6, 7: valid7, 8: valid8}
+
+func answersFor(length int) ([]string, error) {
+	answers, ok := answerLists[length]
+	if !ok {
+		return nil, fmt.Errorf("word length must be between %d and %d", MinLength, MaxLength)
+	}
+	return answers, nil
+}
+
+// Answers returns a copy of the possible answers for the given word length
+func Answers(length int) ([]string, error) {
+	answers, err := answersFor(length)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), answers...), nil
+}
+
+// Valid returns a copy of the sorted valid guesses for the given word length,
+// which include every possible answer
+func Valid(length int) ([]string, error) {
+	if _, err := answersFor(length); err != nil {
+		return nil, err
+	}
+	return append([]string(nil), validLists[length]...), nil
+}
+
+func RandomWord(length int) (string, error) {
+	answers, err := answersFor(length)
+	if err != nil {
+		return "", err
+	}
+	index := r.Intn(len(answers))
+	return answers[index], nil
+}
+
+// DailyWord returns the answer and puzzle number for the calendar date of t,
+// so that every player gets the same word on the same day
+func DailyWord(t time.Time, length int) (string, int, error) {
+	answers, err := answersFor(length)
+	if err != nil {
+		return "", 0, err
+	}
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	puzzle := int(date.Sub(dailyEpoch).Hours() / 24)
+	index := puzzle % len(answers)
+	if index < 0 {
+		index += len(answers)
+	}
+	return answers[index], puzzle, nil
+}
+
+// IsAnswer reports whether word is one of the possible answers for its length
+func IsAnswer(word string) bool {
+	answers, err := answersFor(len(word))
+	if err != nil {
+		return false
+	}
+	for _, answer := range answers {
+		if word == answer {
+			return true
+		}
+	}
+	return false
 }
 
 func IsValidGuess(guess string) bool {
-	if len(guess) != 5 {
+	valid, ok := validLists[len(guess)]
+	if !ok {
 		return false
 	}
 	high := len(valid) - 1